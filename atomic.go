@@ -0,0 +1,214 @@
+package opzione
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+type atomicState[T any] struct {
+	v       T
+	some    bool
+	version uint64
+}
+
+// AtomicOption is an optional value which can be accessed by multiple
+// goroutines simultaneously. It is lock-free, as every modification replaces
+// an immutable snapshot through atomic.Pointer. Every modification also
+// increments the version of the AtomicOption, which enables optimistic
+// read-modify-write cycles without holding locks across the computation:
+//
+//	for {
+//		v, version, ok := opt.LoadVersioned()
+//		if opt.StoreIfVersion(compute(v, ok), version) {
+//			break
+//		}
+//	}
+//
+// Unlike Option, AtomicOption does not track nested references; whether a
+// value is meaningful is decided when it is stored. The zero value of
+// AtomicOption contains no value, at version 0.
+type AtomicOption[T any] struct {
+	p atomic.Pointer[atomicState[T]]
+}
+
+// NewAtomic constructs an AtomicOption containing v.
+func NewAtomic[T any](v T) *AtomicOption[T] {
+	a := new(AtomicOption[T])
+	a.Store(v)
+	return a
+}
+
+// IsNone reports whether the AtomicOption contains no meaningful value.
+func (a *AtomicOption[T]) IsNone() bool {
+	_, ok := a.Load()
+	return !ok
+}
+
+// IsSome reports whether the AtomicOption contains a meaningful value.
+func (a *AtomicOption[T]) IsSome() bool {
+	return !a.IsNone()
+}
+
+// Load returns the contained value, and whether it is meaningful.
+func (a *AtomicOption[T]) Load() (T, bool) {
+	t, _, ok := a.LoadVersioned()
+	return t, ok
+}
+
+// Value returns the contained value, or ErrNoneOptional if there is none.
+func (a *AtomicOption[T]) Value() (T, error) {
+	t, ok := a.Load()
+	if !ok {
+		return t, ErrNoneOptional
+	}
+	return t, nil
+}
+
+// Get returns the contained value, and whether it is meaningful, as Load
+// does.
+func (a *AtomicOption[T]) Get() (T, bool) {
+	return a.Load()
+}
+
+// Unwrap returns the contained value, panicking if there is none.
+func (a *AtomicOption[T]) Unwrap() T {
+	t, ok := a.Load()
+	if !ok {
+		Raise[T](ErrNoneOptional)
+	}
+	return t
+}
+
+// Swap stores v and returns the previous value, which is the zero value if
+// the AtomicOption contained no value, as described by Optional.
+func (a *AtomicOption[T]) Swap(v T) T {
+	for {
+		old := a.p.Load()
+		if a.p.CompareAndSwap(old, newAtomicState(v, old)) {
+			if old == nil {
+				var t T
+				return t
+			}
+			return old.v
+		}
+	}
+}
+
+// Take moves the contained value out, leaving the AtomicOption in a "none"
+// state. It returns ErrNoneOptional if there is no meaningful value.
+func (a *AtomicOption[T]) Take() (*T, error) {
+	for {
+		old := a.p.Load()
+		if old == nil || !old.some {
+			return nil, ErrNoneOptional
+		}
+		if a.p.CompareAndSwap(old, &atomicState[T]{version: old.version + 1}) {
+			return &old.v, nil
+		}
+	}
+}
+
+// CompareAndSwap stores new if the AtomicOption contains a meaningful value
+// equal to old, reporting whether new has been stored. The type of values
+// must be comparable, or CompareAndSwap panics; use CompareAndSwapFunc for
+// other types.
+func (a *AtomicOption[T]) CompareAndSwap(old, new T) bool {
+	return a.CompareAndSwapFunc(old, new, equal[T])
+}
+
+// CompareAndSwapFunc is like CompareAndSwap, but uses eq to compare values.
+func (a *AtomicOption[T]) CompareAndSwapFunc(old, new T, eq func(a, b T) bool) bool {
+	for {
+		s := a.p.Load()
+		if s == nil || !s.some || !eq(s.v, old) {
+			return false
+		}
+		if a.p.CompareAndSwap(s, newAtomicState(new, s)) {
+			return true
+		}
+	}
+}
+
+// With executes f with the contained value, if any. Since f receives a
+// snapshot, the AtomicOption may have been modified meanwhile.
+func (a *AtomicOption[T]) With(f func(T)) {
+	if t, ok := a.Load(); ok {
+		f(t)
+	}
+}
+
+// WithNone executes f if the AtomicOption contains no value.
+func (a *AtomicOption[T]) WithNone(f func()) {
+	if a.IsNone() {
+		f()
+	}
+}
+
+// Assign assigns a pointer to a snapshot of the contained value to *p, if
+// there is one. It reports whether an assignment is made.
+func (a *AtomicOption[T]) Assign(p **T) bool {
+	t, ok := a.Load()
+	if ok {
+		*p = &t
+	}
+	return ok
+}
+
+// Iter returns an iterator yielding a snapshot of the contained value, if
+// there is one at the time of iteration.
+func (a *AtomicOption[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t, ok := a.Load(); ok {
+			yield(t)
+		}
+	}
+}
+
+// LoadVersioned returns the contained value, the current version, and
+// whether the value is meaningful.
+func (a *AtomicOption[T]) LoadVersioned() (t T, version uint64, ok bool) {
+	s := a.p.Load()
+	if s == nil {
+		return
+	}
+	return s.v, s.version, s.some
+}
+
+// Store stores v unconditionally. If v is nil or dereferences to nil, the
+// AtomicOption becomes none.
+func (a *AtomicOption[T]) Store(v T) {
+	for {
+		old := a.p.Load()
+		if a.p.CompareAndSwap(old, newAtomicState(v, old)) {
+			return
+		}
+	}
+}
+
+// StoreIfVersion stores v only if the current version equals version, as
+// obtained from LoadVersioned. It reports whether v has been stored.
+func (a *AtomicOption[T]) StoreIfVersion(v T, version uint64) bool {
+	old := a.p.Load()
+	if old.current() != version {
+		return false
+	}
+	return a.p.CompareAndSwap(old, newAtomicState(v, old))
+}
+
+func newAtomicState[T any](v T, old *atomicState[T]) *atomicState[T] {
+	_, null := classify(&v)
+	return &atomicState[T]{v: v, some: !null, version: old.current() + 1}
+}
+
+// current returns the version of s, which may be nil.
+func (s *atomicState[T]) current() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.version
+}
+
+// equal compares a and b with ==, panicking if they are not comparable.
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
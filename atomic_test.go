@@ -0,0 +1,103 @@
+package opzione
+
+import (
+	"sync"
+	"testing"
+)
+
+// Interface assertions
+var _ Optional[int] = &AtomicOption[int]{}
+
+func TestAtomicOption_Versioned(t *testing.T) {
+	var option AtomicOption[int]
+	if _, version, ok := option.LoadVersioned(); ok || version != 0 {
+		t.Error("Unexpected state:", version, ok)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, version, _ := option.LoadVersioned()
+				if option.StoreIfVersion(v+1, version) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	v, version, ok := option.LoadVersioned()
+	if !ok || v != 50 || version != 50 {
+		t.Error("Unexpected state:", v, version, ok)
+	}
+	if option.StoreIfVersion(0, 49) {
+		t.Error("Stored with a stale version")
+	}
+
+	var ptr AtomicOption[*int]
+	ptr.Store(nil)
+	if _, version, ok := ptr.LoadVersioned(); ok || version != 1 {
+		t.Error("Unexpected state:", version, ok)
+	}
+}
+
+func TestAtomicOption(t *testing.T) {
+	option := NewAtomic(1)
+	if option.IsNone() || option.Unwrap() != 1 {
+		t.Error("Unexpected state")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			option.Swap(2)
+			option.IsNone()
+			_, _ = option.Take()
+		}()
+	}
+	wg.Wait()
+
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err := option.Take(); err != ErrNoneOptional {
+		t.Error("Unexpected error:", err)
+	}
+	if old := option.Swap(3); old != 0 {
+		t.Error("Unexpected old value:", old)
+	}
+
+	var p *int
+	if !option.Assign(&p) || *p != 3 {
+		t.Error("Assign failed")
+	}
+}
+
+func TestAtomicOption_CompareAndSwap(t *testing.T) {
+	option := NewAtomic(1)
+	if option.CompareAndSwap(2, 3) {
+		t.Error("Swapped with a different old value")
+	}
+	if !option.CompareAndSwap(1, 2) || option.Unwrap() != 2 {
+		t.Error("Not swapped with the same old value")
+	}
+
+	slices := NewAtomic([]int{1})
+	ShouldPanic(t, func() {
+		slices.CompareAndSwap([]int{1}, []int{2})
+	}, true)
+	eq := func(a, b []int) bool { return len(a) == len(b) && a[0] == b[0] }
+	if !slices.CompareAndSwapFunc([]int{1}, []int{2}, eq) {
+		t.Error("Not swapped with an equal old value")
+	}
+
+	var none AtomicOption[int]
+	if none.CompareAndSwap(0, 1) {
+		t.Error("Swapped None")
+	}
+}
@@ -0,0 +1,50 @@
+package opzione
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+)
+
+// binaryCodec encodes values which implement encoding.BinaryMarshaler, or
+// are of fixed size as understood by encoding/binary.
+type binaryCodec[T any] struct{}
+
+func (binaryCodec[T]) Encode(v T) ([]byte, error) {
+	if m, ok := any(v).(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.BigEndian, v); err != nil {
+		return nil, fmt.Errorf("opzione: cannot marshal %v: %w", typeName[T](), err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (binaryCodec[T]) Decode(data []byte) (v T, err error) {
+	if u, ok := unmarshaler[encoding.BinaryUnmarshaler](&v); ok {
+		err = u.UnmarshalBinary(data)
+		return
+	}
+
+	if err = binary.Read(bytes.NewReader(data), binary.BigEndian, &v); err != nil {
+		err = fmt.Errorf("opzione: cannot unmarshal %v: %w", typeName[T](), err)
+	}
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for element types which
+// implement it themselves, or are of fixed size. The output is a presence
+// byte followed by the binary form of the contained value, if any; fixed-size
+// values are encoded in big-endian byte order.
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	return o.MarshalWith(binaryCodec[T]{})
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding data
+// produced by MarshalBinary.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	return o.UnmarshalWith(binaryCodec[T]{}, data)
+}
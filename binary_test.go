@@ -0,0 +1,54 @@
+package opzione
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOption_Binary(t *testing.T) {
+	type point struct {
+		X, Y int32
+	}
+
+	data, err := Some(point{1, 2}).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 9 {
+		t.Error("Unexpected length:", len(data))
+	}
+
+	var option Option[point]
+	if err = option.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if option.Unwrap() != (point{1, 2}) {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	// Pointers to unmarshalers are allocated with reflection.
+	if !noreflect {
+		now := time.Now()
+		if data, err = Some(now).MarshalBinary(); err != nil {
+			t.Fatal(err)
+		}
+		var timeOption Option[*time.Time]
+		if err = timeOption.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !timeOption.Unwrap().Equal(now) {
+			t.Error("Unexpected value:", timeOption.Unwrap())
+		}
+	}
+
+	if data, err = None[point]().MarshalBinary(); err != nil {
+		t.Fatal(err)
+	}
+	if err = option.UnmarshalBinary(data); err != nil || !option.IsNone() {
+		t.Error("Unexpected result:", err)
+	}
+
+	if _, err = Some("string").MarshalBinary(); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
@@ -0,0 +1,60 @@
+// Package bsonopt provides optional values which are marshalled as BSON by
+// go.mongodb.org/mongo-driver/v2. It is a separate module, so that opzione
+// does not depend on the driver.
+//
+//	type User struct {
+//		Name  bsonopt.Option[string] `bson:"name"`
+//		Email bsonopt.Option[string] `bson:"email,omitempty"`
+//	}
+package bsonopt
+
+import (
+	"github.com/oissevalt/opzione"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Option is an opzione.Option which implements bson.ValueMarshaler and
+// bson.ValueUnmarshaler. The contained value is marshalled as it is, and an
+// Option containing no meaningful value is marshalled as BSON null. Fields
+// tagged with omitempty are omitted if None, as the Option implements
+// IsZero.
+type Option[T any] struct {
+	opzione.Option[T]
+}
+
+// Some returns an Option containing v, panicking as opzione.Some does if v
+// is nil.
+func Some[T any](v T) Option[T] {
+	return Option[T]{opzione.S(v)}
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{opzione.N[T]()}
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (o Option[T]) MarshalBSONValue() (byte, []byte, error) {
+	v, ok := o.Get()
+	if !ok {
+		return byte(bson.TypeNull), nil, nil
+	}
+	typ, data, err := bson.MarshalValue(v)
+	return byte(typ), data, err
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler. BSON null and
+// undefined result in None.
+func (o *Option[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	if t := bson.Type(typ); t == bson.TypeNull || t == bson.TypeUndefined {
+		_, _ = o.Take()
+		return nil
+	}
+
+	var v T
+	if err := bson.UnmarshalValue(bson.Type(typ), data, &v); err != nil {
+		return err
+	}
+	o.Swap(v)
+	return nil
+}
@@ -0,0 +1,45 @@
+package bsonopt
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Interface assertions
+var (
+	_ bson.ValueMarshaler   = Option[int]{}
+	_ bson.ValueUnmarshaler = &Option[int]{}
+)
+
+func TestOption_BSON(t *testing.T) {
+	type document struct {
+		Name  Option[string] `bson:"name"`
+		Count Option[int32]  `bson:"count,omitempty"`
+		Tags  Option[string] `bson:"tags"`
+	}
+
+	data, err := bson.Marshal(document{Name: Some("a"), Count: None[int32](), Tags: None[string]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw bson.M
+	if err = bson.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := raw["count"]; ok {
+		t.Error("None not omitted:", raw)
+	}
+	if v, ok := raw["tags"]; !ok || v != nil {
+		t.Error("None not marshalled as null:", raw)
+	}
+
+	doc := document{Tags: Some("b")}
+	if err = bson.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Name.Unwrap() != "a" || !doc.Count.IsNone() || !doc.Tags.IsNone() {
+		t.Error("Unexpected result:", doc)
+	}
+}
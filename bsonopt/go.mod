@@ -0,0 +1,8 @@
+module github.com/oissevalt/opzione/bsonopt
+
+go 1.25.0
+
+require (
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+	go.mongodb.org/mongo-driver/v2 v2.9.1
+)
@@ -0,0 +1,64 @@
+// Package cboropt provides optional values which are marshalled as CBOR by
+// github.com/fxamacker/cbor/v2. It is a separate module, so that opzione
+// does not depend on the library.
+//
+//	type Reading struct {
+//		Sensor string                  `cbor:"sensor"`
+//		Value  cboropt.Option[float64] `cbor:"value"`
+//	}
+package cboropt
+
+import (
+	"bytes"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/oissevalt/opzione"
+)
+
+var (
+	cborNull      = []byte{0xf6}
+	cborUndefined = []byte{0xf7}
+)
+
+// Option is an opzione.Option which implements cbor.Marshaler and
+// cbor.Unmarshaler. The contained value is marshalled as it is, and an
+// Option containing no meaningful value is marshalled as CBOR null.
+type Option[T any] struct {
+	opzione.Option[T]
+}
+
+// Some returns an Option containing v, panicking as opzione.Some does if v
+// is nil.
+func Some[T any](v T) Option[T] {
+	return Option[T]{opzione.S(v)}
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{opzione.N[T]()}
+}
+
+// MarshalCBOR implements cbor.Marshaler.
+func (o Option[T]) MarshalCBOR() ([]byte, error) {
+	v, ok := o.Get()
+	if !ok {
+		return cborNull, nil
+	}
+	return cbor.Marshal(v)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler. CBOR null and undefined result
+// in None.
+func (o *Option[T]) UnmarshalCBOR(data []byte) error {
+	if bytes.Equal(data, cborNull) || bytes.Equal(data, cborUndefined) {
+		_, _ = o.Take()
+		return nil
+	}
+
+	var v T
+	if err := cbor.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Swap(v)
+	return nil
+}
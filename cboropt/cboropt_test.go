@@ -0,0 +1,41 @@
+package cboropt
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Interface assertions
+var (
+	_ cbor.Marshaler   = Option[int]{}
+	_ cbor.Unmarshaler = &Option[int]{}
+)
+
+func TestOption_CBOR(t *testing.T) {
+	type reading struct {
+		Sensor Option[string]  `cbor:"sensor"`
+		Value  Option[float64] `cbor:"value"`
+	}
+
+	data, err := cbor.Marshal(reading{Sensor: Some("t1"), Value: None[float64]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]any
+	if err = cbor.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := raw["value"]; !ok || v != nil {
+		t.Error("None not marshalled as null:", raw)
+	}
+
+	r := reading{Value: Some(1.5)}
+	if err = cbor.Unmarshal(data, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Sensor.Unwrap() != "t1" || !r.Value.IsNone() {
+		t.Error("Unexpected result:", r)
+	}
+}
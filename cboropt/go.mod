@@ -0,0 +1,10 @@
+module github.com/oissevalt/opzione/cboropt
+
+go 1.23
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.4
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+)
+
+require github.com/x448/float16 v0.8.4 // indirect
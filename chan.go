@@ -0,0 +1,28 @@
+package opzione
+
+import "time"
+
+// TryRecv receives a value from ch without blocking. It returns None if no
+// value is ready, or if ch is closed.
+func TryRecv[T any](ch <-chan T) *Option[T] {
+	select {
+	case v, ok := <-ch:
+		return Of(v, ok)
+	default:
+		return None[T]()
+	}
+}
+
+// RecvTimeout receives a value from ch, waiting for at most d. It returns
+// None if no value is received in time, or if ch is closed.
+func RecvTimeout[T any](ch <-chan T, d time.Duration) *Option[T] {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case v, ok := <-ch:
+		return Of(v, ok)
+	case <-timer.C:
+		return None[T]()
+	}
+}
@@ -0,0 +1,37 @@
+package opzione
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	if option := TryRecv(ch); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	ch <- 1
+	if option := TryRecv(ch); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	close(ch)
+	if option := TryRecv(ch); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestRecvTimeout(t *testing.T) {
+	ch := make(chan int)
+	if option := RecvTimeout(ch, time.Millisecond); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	go func() {
+		ch <- 1
+	}()
+	if option := RecvTimeout(ch, time.Second); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+}
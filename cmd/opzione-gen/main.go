@@ -0,0 +1,225 @@
+// Opzione-gen generates type-specialized optional types which implement
+// opzione.Optional without reflection. It is intended for latency-critical
+// code, where the generic Option would otherwise classify values at run
+// time.
+//
+// Given the name of a type T, opzione-gen emits a type OptionT with
+// constructors SomeT and NoneT. Like opzione.Val, the generated type stores
+// the value inline and does not inspect it, so a nil pointer is considered
+// present. Names are unexported if T is unexported.
+//
+// Typical usage is with go generate:
+//
+//	//go:generate opzione-gen -type=User,Order
+//
+// Flags:
+//
+//	-type     comma-separated list of type names; required
+//	-package  package name; defaults to $GOPACKAGE
+//	-output   output file; defaults to <type>_option.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("opzione-gen: ")
+
+	var (
+		types  = flag.String("type", "", "comma-separated list of type names; required")
+		pkg    = flag.String("package", os.Getenv("GOPACKAGE"), "package name")
+		output = flag.String("output", "", "output file; defaults to <type>_option.go")
+	)
+	flag.Parse()
+
+	if *types == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	names := strings.Split(*types, ",")
+	if *output == "" {
+		*output = strings.ToLower(names[0]) + "_option.go"
+	}
+
+	src, err := generate(*pkg, names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// spec names the declarations generated for a type.
+type spec struct {
+	Type   string
+	Option string
+	Some   string
+	None   string
+}
+
+func newSpec(typ string) (spec, error) {
+	if !token.IsIdentifier(typ) {
+		return spec{}, fmt.Errorf("invalid type name %q", typ)
+	}
+	r, n := utf8.DecodeRuneInString(typ)
+	suffix := string(unicode.ToUpper(r)) + typ[n:]
+	if token.IsExported(typ) {
+		return spec{typ, "Option" + suffix, "Some" + suffix, "None" + suffix}, nil
+	}
+	return spec{typ, "option" + suffix, "some" + suffix, "none" + suffix}, nil
+}
+
+// generate returns the formatted source of package pkg declaring an optional
+// type for each of types.
+func generate(pkg string, types []string) ([]byte, error) {
+	if pkg == "" {
+		return nil, errors.New("package name is unknown; set -package")
+	}
+
+	specs := make([]spec, 0, len(types))
+	for _, typ := range types {
+		s, err := newSpec(strings.TrimSpace(typ))
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, s)
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		Package string
+		Specs   []spec
+	}{pkg, specs})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by opzione-gen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"iter"
+
+	"github.com/oissevalt/opzione"
+)
+{{range .Specs}}
+// {{.Option}} is an optional {{.Type}}, which is checked without reflection.
+// The zero value contains no value.
+type {{.Option}} struct {
+	v    {{.Type}}
+	some bool
+}
+
+var _ opzione.Optional[{{.Type}}] = (*{{.Option}})(nil)
+
+// {{.Some}} constructs an {{.Option}} containing v.
+func {{.Some}}(v {{.Type}}) {{.Option}} {
+	return {{.Option}}{v: v, some: true}
+}
+
+// {{.None}} constructs an {{.Option}} with no value.
+func {{.None}}() {{.Option}} {
+	return {{.Option}}{}
+}
+
+// IsNone reports whether the {{.Option}} contains no value.
+func (o *{{.Option}}) IsNone() bool {
+	return !o.some
+}
+
+// IsSome reports whether the {{.Option}} contains a value.
+func (o *{{.Option}}) IsSome() bool {
+	return o.some
+}
+
+// Value returns the contained value, or opzione.ErrNoneOptional if there
+// is none.
+func (o *{{.Option}}) Value() (t {{.Type}}, err error) {
+	if !o.some {
+		return t, opzione.ErrNoneOptional
+	}
+	return o.v, nil
+}
+
+// Get returns the contained value, and whether there is one.
+func (o *{{.Option}}) Get() ({{.Type}}, bool) {
+	return o.v, o.some
+}
+
+// Unwrap returns the contained value. Like opzione.Option, it panics by
+// opzione.Raise if there is none.
+func (o *{{.Option}}) Unwrap() {{.Type}} {
+	if !o.some {
+		opzione.Raise[{{.Type}}](opzione.ErrNoneOptional)
+	}
+	return o.v
+}
+
+// Swap stores v and returns the original value, which is the zero value if
+// the {{.Option}} contained no value.
+func (o *{{.Option}}) Swap(v {{.Type}}) {{.Type}} {
+	t := o.v
+	o.v, o.some = v, true
+	return t
+}
+
+// Take moves the contained value out, leaving the {{.Option}} with no value.
+// It returns opzione.ErrNoneOptional if there is no value.
+func (o *{{.Option}}) Take() (*{{.Type}}, error) {
+	if !o.some {
+		return nil, opzione.ErrNoneOptional
+	}
+	t := o.v
+	*o = {{.Option}}{}
+	return &t, nil
+}
+
+// With executes f with the contained value, if any.
+func (o *{{.Option}}) With(f func({{.Type}})) {
+	if o.some {
+		f(o.v)
+	}
+}
+
+// WithNone executes f if the {{.Option}} contains no value.
+func (o *{{.Option}}) WithNone(f func()) {
+	if !o.some {
+		f()
+	}
+}
+
+// Assign assigns a pointer to the contained value to *p, if there is one.
+// It reports whether an assignment is made.
+func (o *{{.Option}}) Assign(p **{{.Type}}) bool {
+	if o.some {
+		*p = &o.v
+	}
+	return o.some
+}
+
+// Iter returns an iterator yielding the contained value, if any.
+func (o *{{.Option}}) Iter() iter.Seq[{{.Type}}] {
+	return func(yield func({{.Type}}) bool) {
+		if o.some {
+			yield(o.v)
+		}
+	}
+}
+{{end}}`))
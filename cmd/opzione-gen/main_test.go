@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("users", []string{"User", "order"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"type OptionUser struct",
+		"func SomeUser(v User) OptionUser",
+		"func NoneUser() OptionUser",
+		"type optionOrder struct",
+		"func someOrder(v order) optionOrder",
+		"opzione.Raise[User](opzione.ErrNoneOptional)",
+		"// Unwrap returns the contained value.",
+	} {
+		if !strings.Contains(string(src), s) {
+			t.Error("Missing declaration:", s)
+		}
+	}
+
+	if _, err = generate("", []string{"User"}); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if _, err = generate("users", []string{"*User"}); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestGenerateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping compilation in short mode")
+	}
+
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate("users", []string{"User"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module users\n\ngo 1.23\n\n" +
+			"require github.com/oissevalt/opzione v0.0.0\n\n" +
+			"replace github.com/oissevalt/opzione => " + root + "\n",
+		"user.go":        "package users\n\ntype User struct{ Name string }\n",
+		"user_option.go": string(src),
+		"user_test.go": `package users
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/oissevalt/opzione"
+)
+
+func recovered(f func()) (err *opzione.NonePanicError) {
+	defer func() {
+		err, _ = recover().(*opzione.NonePanicError)
+	}()
+	f()
+	return
+}
+
+func TestUser(t *testing.T) {
+	o := SomeUser(User{"a"})
+	if o.IsNone() || o.Unwrap().Name != "a" {
+		t.Error("Unexpected value")
+	}
+	if p, err := o.Take(); err != nil || p.Name != "a" || !o.IsNone() {
+		t.Error("Unexpected Take result")
+	}
+
+	err := recovered(func() { o.Unwrap() })
+	want := recovered(func() { opzione.None[User]().Unwrap() })
+	if err == nil || want == nil || *err != *want || !errors.Is(err, opzione.ErrNoneOptional) {
+		t.Error("Unexpected panic:", err, want)
+	}
+
+	var handled error
+	opzione.SetPanicHandler(func(err error) { handled = err })
+	defer opzione.SetPanicHandler(nil)
+	if recovered(func() { o.Unwrap() }); handled == nil {
+		t.Error("Panic handler not called")
+	}
+}
+`,
+	}
+	for name, content := range files {
+		if err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command("go", "test", "-mod=mod", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
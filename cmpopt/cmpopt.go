@@ -0,0 +1,47 @@
+// Package cmpopt provides options for comparing optional values with
+// github.com/google/go-cmp, which cannot compare the unexported fields of
+// opzione.Option by itself:
+//
+//	cmp.Diff(want, got, cmpopt.Transformer[int]())
+package cmpopt
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/oissevalt/opzione"
+)
+
+// Some represents an Option containing Value in the output of cmp.Diff.
+type Some[T any] struct {
+	Value T
+}
+
+// None represents an Option containing no meaningful value in the output of
+// cmp.Diff.
+type None struct{}
+
+// Transformer returns a cmp.Option which transforms each opzione.Option[T]
+// into Some or None, such that two Nones are equal, two Somes are compared by
+// their values, and differences are reported as Some(v) and None.
+func Transformer[T any]() cmp.Option {
+	return cmp.Transformer("opzione.Option", func(o opzione.Option[T]) any {
+		v, err := o.Value()
+		if err != nil {
+			return None{}
+		}
+		return Some[T]{Value: v}
+	})
+}
+
+// Comparer returns a cmp.Option which compares each pair of opzione.Option[T]
+// directly, such that two Nones are equal, and two Somes are equal if their
+// values are equal as reported by cmp.Equal with opts.
+func Comparer[T any](opts ...cmp.Option) cmp.Option {
+	return cmp.Comparer(func(a, b opzione.Option[T]) bool {
+		x, errx := a.Value()
+		y, erry := b.Value()
+		if errx != nil || erry != nil {
+			return errx != nil && erry != nil
+		}
+		return cmp.Equal(x, y, opts...)
+	})
+}
@@ -0,0 +1,47 @@
+package cmpopt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oissevalt/opzione"
+)
+
+type user struct {
+	Name opzione.Option[string]
+	Age  *opzione.Option[int]
+}
+
+func TestTransformer(t *testing.T) {
+	opts := cmp.Options{Transformer[string](), Transformer[int]()}
+
+	a := user{Name: opzione.N[string](), Age: opzione.Some(1)}
+	b := user{Name: opzione.N[string](), Age: opzione.Some(1)}
+	if diff := cmp.Diff(a, b, opts); diff != "" {
+		t.Error("Unexpected diff:", diff)
+	}
+
+	b.Age.Swap(2)
+	b.Name = opzione.S("b")
+	diff := cmp.Diff(a, b, opts)
+	for _, s := range []string{"None{}", `Some[string]{Value: "b"}`, "Value: 1", "Value: 2"} {
+		if !strings.Contains(diff, s) {
+			t.Errorf("Missing %q in diff:\n%s", s, diff)
+		}
+	}
+}
+
+func TestComparer(t *testing.T) {
+	opts := cmp.Options{Comparer[string](), Comparer[int]()}
+
+	a := user{Name: opzione.S("a"), Age: opzione.None[int]()}
+	b := user{Name: opzione.S("a"), Age: opzione.None[int]()}
+	if !cmp.Equal(a, b, opts) {
+		t.Error("Unexpected inequality")
+	}
+	b.Name = opzione.N[string]()
+	if cmp.Equal(a, b, opts) {
+		t.Error("Unexpected equality")
+	}
+}
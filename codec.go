@@ -0,0 +1,54 @@
+package opzione
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Codec encodes and decodes values of type T in an arbitrary wire format.
+type Codec[T any] interface {
+	Encode(v T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+const (
+	presenceNone byte = iota
+	presenceSome
+)
+
+var errNoPresence = errors.New("opzione: missing presence byte")
+
+// MarshalWith encodes the Option using c. The output consists of a presence
+// byte, followed by the encoded value if the Option contains a meaningful
+// value, so that None is distinguishable from any encoded value.
+func (o *Option[T]) MarshalWith(c Codec[T]) ([]byte, error) {
+	if o.IsNone() {
+		return []byte{presenceNone}, nil
+	}
+	data, err := c.Encode(*o.v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{presenceSome}, data...), nil
+}
+
+// UnmarshalWith decodes data produced by MarshalWith into the Option using c.
+func (o *Option[T]) UnmarshalWith(c Codec[T], data []byte) error {
+	if len(data) == 0 {
+		return errNoPresence
+	}
+	switch data[0] {
+	case presenceNone:
+		o.unset()
+		return nil
+	case presenceSome:
+		v, err := c.Decode(data[1:])
+		if err != nil {
+			return err
+		}
+		o.set(v)
+		return nil
+	default:
+		return fmt.Errorf("opzione: invalid presence byte %#x", data[0])
+	}
+}
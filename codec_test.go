@@ -0,0 +1,48 @@
+package opzione
+
+import (
+	"strconv"
+	"testing"
+)
+
+type intCodec struct{}
+
+func (intCodec) Encode(v int) ([]byte, error) {
+	return []byte(strconv.Itoa(v)), nil
+}
+
+func (intCodec) Decode(data []byte) (int, error) {
+	return strconv.Atoi(string(data))
+}
+
+func TestOption_MarshalWith(t *testing.T) {
+	data, err := Some(12).MarshalWith(intCodec{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var option Option[int]
+	if err = option.UnmarshalWith(intCodec{}, data); err != nil {
+		t.Fatal(err)
+	}
+	if option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	if data, err = None[int]().MarshalWith(intCodec{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = option.UnmarshalWith(intCodec{}, data); err != nil {
+		t.Fatal(err)
+	}
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if err = option.UnmarshalWith(intCodec{}, nil); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if err = option.UnmarshalWith(intCodec{}, []byte{2}); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
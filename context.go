@@ -0,0 +1,29 @@
+package opzione
+
+import "context"
+
+// IntoContext returns a copy of ctx in which the value associated with key
+// is o. Storing None shadows any value associated with key by the parents
+// of ctx.
+func IntoContext[T any](ctx context.Context, key any, o Optional[T]) context.Context {
+	return context.WithValue(ctx, key, o)
+}
+
+// FromContext retrieves the value associated with key in ctx as an Option.
+// The value may have been stored by IntoContext, or directly as a T by
+// context.WithValue. It returns None if there is no such value, the value
+// is of a different type, or it is nil, including a nil *Option.
+func FromContext[T any](ctx context.Context, key any) *Option[T] {
+	switch v := ctx.Value(key).(type) {
+	case Optional[T]:
+		if isnilany(v) || v.IsNone() {
+			return None[T]()
+		}
+		t := v.Unwrap()
+		return FromPointer(&t)
+	case T:
+		return FromPointer(&v)
+	default:
+		return None[T]()
+	}
+}
@@ -0,0 +1,42 @@
+package opzione
+
+import (
+	"context"
+	"testing"
+)
+
+type contextKey struct{}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if option := FromContext[string](ctx, contextKey{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	ctx = IntoContext[string](ctx, contextKey{}, Some("user"))
+	if option := FromContext[string](ctx, contextKey{}); option.Unwrap() != "user" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := FromContext[int](ctx, contextKey{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	child := IntoContext[string](ctx, contextKey{}, None[string]())
+	if option := FromContext[string](child, contextKey{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	ctx = context.WithValue(ctx, contextKey{}, "plain")
+	if option := FromContext[string](ctx, contextKey{}); option.Unwrap() != "plain" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	ctx = IntoContext[string](ctx, contextKey{}, (*Option[string])(nil))
+	if option := FromContext[string](ctx, contextKey{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	ctx = context.WithValue(ctx, contextKey{}, (*LockedOption[string])(nil))
+	if option := FromContext[string](ctx, contextKey{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,6 @@
+//go:build opzione_debug
+
+package opzione
+
+// debug enables additional runtime checks.
+const debug = true
@@ -0,0 +1,54 @@
+//go:build opzione_noreflect && !tinygo
+
+package opzione
+
+import "unsafe"
+
+// eface is how the gc toolchain represents an empty interface. Values of
+// pointer-shaped types, such as pointers, maps, channels and functions, are
+// stored in the data word directly, whereas other values are stored behind
+// a pointer which is never nil.
+type eface struct {
+	typ  *rtype
+	data unsafe.Pointer
+}
+
+// rtype is the prefix of how the gc toolchain describes a type, up to its
+// kind, which has kept its layout across releases.
+type rtype struct {
+	size       uintptr
+	ptrbytes   uintptr
+	hash       uint32
+	tflag      uint8
+	align      uint8
+	fieldAlign uint8
+	kind       uint8
+}
+
+// Kinds of pointer types, as numbered by reflect.Kind.
+const (
+	kindChan          = 18
+	kindFunc          = 19
+	kindMap           = 21
+	kindPointer       = 22
+	kindUnsafePointer = 26
+
+	// kindMask masks out the flags stored along with the kind.
+	kindMask = 1<<5 - 1
+)
+
+// isnilany reports whether x is a nil interface, or holds a nil pointer, map,
+// channel or function, including a typed nil. Other values, such as structs
+// and arrays consisting of a single pointer, are never nil.
+func isnilany(x any) bool {
+	e := (*eface)(unsafe.Pointer(&x))
+	if e.typ == nil {
+		return true
+	}
+	switch e.typ.kind & kindMask {
+	case kindChan, kindFunc, kindMap, kindPointer, kindUnsafePointer:
+		return e.data == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,10 @@
+//go:build opzione_noreflect && tinygo
+
+package opzione
+
+// isnilany reports whether x is a nil interface. TinyGo may store small
+// values of any type in the data word of an interface, so nil pointers
+// cannot be told apart from zero values without reflection.
+func isnilany(x any) bool {
+	return x == nil
+}
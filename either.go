@@ -0,0 +1,68 @@
+package opzione
+
+// Either is a container holding exactly one of two values, a left value of
+// type L or a right value of type R.
+type Either[L, R any] struct {
+	l     L
+	r     R
+	right bool
+}
+
+// Left constructs an Either with left value v.
+func Left[L, R any](v L) *Either[L, R] {
+	return &Either[L, R]{l: v}
+}
+
+// Right constructs an Either with right value v.
+func Right[L, R any](v R) *Either[L, R] {
+	return &Either[L, R]{r: v, right: true}
+}
+
+// IsLeft reports whether the Either holds a left value.
+func (e *Either[L, R]) IsLeft() bool {
+	return !e.right
+}
+
+// IsRight reports whether the Either holds a right value.
+func (e *Either[L, R]) IsRight() bool {
+	return e.right
+}
+
+// Left returns an Option of the left value, which is None if the Either
+// holds a right value, or if the left value is nil.
+func (e *Either[L, R]) Left() *Option[L] {
+	if e.right {
+		return None[L]()
+	}
+	return FromPointer(&e.l)
+}
+
+// Right returns an Option of the right value, which is None if the Either
+// holds a left value, or if the right value is nil.
+func (e *Either[L, R]) Right() *Option[R] {
+	if !e.right {
+		return None[R]()
+	}
+	return FromPointer(&e.r)
+}
+
+// Swap returns a new Either with the left and right sides exchanged.
+func (e *Either[L, R]) Swap() *Either[R, L] {
+	return &Either[R, L]{l: e.r, r: e.l, right: !e.right}
+}
+
+// MapLeft applies f to the left value of e, if it holds one.
+func MapLeft[L, R, M any](e *Either[L, R], f func(L) M) *Either[M, R] {
+	if e.right {
+		return Right[M](e.r)
+	}
+	return Left[M, R](f(e.l))
+}
+
+// MapRight applies f to the right value of e, if it holds one.
+func MapRight[L, R, M any](e *Either[L, R], f func(R) M) *Either[L, M] {
+	if !e.right {
+		return Left[L, M](e.l)
+	}
+	return Right[L](f(e.r))
+}
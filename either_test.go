@@ -0,0 +1,34 @@
+package opzione
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	left := Left[int, string](1)
+	if !left.IsLeft() || left.IsRight() {
+		t.Error("Unexpected side")
+	}
+	if left.Left().Unwrap() != 1 || !left.Right().IsNone() {
+		t.Error("Unexpected conversion")
+	}
+
+	mapped := MapLeft(left, strconv.Itoa)
+	if mapped.Left().Unwrap() != "1" {
+		t.Error("Unexpected value:", mapped.Left().Unwrap())
+	}
+	if unmapped := MapRight(left, func(s string) int { return len(s) }); !unmapped.IsLeft() {
+		t.Error("Unexpected side")
+	}
+
+	swapped := left.Swap()
+	if !swapped.IsRight() || swapped.Right().Unwrap() != 1 {
+		t.Error("Unexpected swap")
+	}
+
+	right := MapRight(Right[int]("2"), func(s string) int { return len(s) })
+	if right.Right().Unwrap() != 1 {
+		t.Error("Unexpected value:", right.Right().Unwrap())
+	}
+}
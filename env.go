@@ -0,0 +1,41 @@
+package opzione
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// LookupEnv retrieves the value of the environment variable named by key,
+// returning None if the variable is not present.
+func LookupEnv(key string) *Option[string] {
+	return Of(os.LookupEnv(key))
+}
+
+// LookupEnvInt retrieves the environment variable named by key as an int,
+// returning None if the variable is not present or cannot be parsed.
+func LookupEnvInt(key string) *Option[int] {
+	return lookupEnv(key, strconv.Atoi)
+}
+
+// LookupEnvBool retrieves the environment variable named by key as a bool,
+// as parsed by strconv.ParseBool, returning None if the variable is not
+// present or cannot be parsed.
+func LookupEnvBool(key string) *Option[bool] {
+	return lookupEnv(key, strconv.ParseBool)
+}
+
+// LookupEnvDuration retrieves the environment variable named by key as a
+// time.Duration, as parsed by time.ParseDuration, returning None if the
+// variable is not present or cannot be parsed.
+func LookupEnvDuration(key string) *Option[time.Duration] {
+	return lookupEnv(key, time.ParseDuration)
+}
+
+func lookupEnv[T any](key string, parse func(string) (T, error)) *Option[T] {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return None[T]()
+	}
+	return Try(parse(s))
+}
@@ -0,0 +1,29 @@
+package opzione
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupEnv(t *testing.T) {
+	t.Setenv("OPZIONE_STRING", "")
+	t.Setenv("OPZIONE_INT", "12")
+	t.Setenv("OPZIONE_BOOL", "yes")
+	t.Setenv("OPZIONE_DURATION", "1m")
+
+	if option := LookupEnv("OPZIONE_STRING"); option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	if option := LookupEnv("OPZIONE_UNSET"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := LookupEnvInt("OPZIONE_INT"); option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := LookupEnvBool("OPZIONE_BOOL"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := LookupEnvDuration("OPZIONE_DURATION"); option.Unwrap() != time.Minute {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+}
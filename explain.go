@@ -0,0 +1,41 @@
+package opzione
+
+import "fmt"
+
+// Explain describes why the Option is None, for debugging: it contains no
+// value, a reference in the contained value is nil, or a validator rejects
+// the contained value. Depth 0 refers to the contained value itself, depth
+// 1 to what it points to, and so on. Explain returns an empty string if the
+// Option contains a meaningful value.
+func (o *Option[T]) Explain() string {
+	m := o.loadMeta()
+	if m == nil {
+		m = new(optionMeta[T])
+	}
+
+	if o.v == nil {
+		if m.cause != nil {
+			return fmt.Sprintf("no value: %v", m.cause)
+		}
+		return "no value"
+	}
+
+	if d := o.nildepth(); d >= 0 {
+		return fmt.Sprintf("nil reference at depth %d", d)
+	}
+	if m.nilslice && o.none() {
+		return "nil slice"
+	}
+
+	if len(m.validfns) > 0 && m.rejected(*o.v) {
+		if m.validall {
+			return "rejected by all validators"
+		}
+		for i, f := range m.validfns {
+			if f(*o.v) {
+				return fmt.Sprintf("rejected by validator %d", i)
+			}
+		}
+	}
+	return ""
+}
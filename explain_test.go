@@ -0,0 +1,61 @@
+package opzione
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestOption_Explain(t *testing.T) {
+	i := 1
+	p := &i
+	pp := &p
+	option := Some(&pp)
+	if s := option.Explain(); s != "" {
+		t.Error("Unexpected explanation:", s)
+	}
+
+	// Nested references are only tracked with reflection.
+	if !noreflect {
+		p = nil
+		if s := option.Explain(); s != "nil reference at depth 2" {
+			t.Error("Unexpected explanation:", s)
+		}
+		pp = nil
+		if s := option.Explain(); s != "nil reference at depth 1" {
+			t.Error("Unexpected explanation:", s)
+		}
+	}
+
+	var perr *os.PathError
+	err := error(perr)
+	if s := FromPointer(&err).Explain(); s != "no value" {
+		t.Error("Unexpected explanation:", s)
+	}
+	if !noreflect {
+		err = &os.PathError{}
+		errs := Some(&err)
+		err = perr
+		if s := errs.Explain(); s != "nil reference at depth 1" {
+			t.Error("Unexpected explanation:", s)
+		}
+	}
+	if s := Try(strconv.Atoi("a")).Explain(); s != `no value: strconv.Atoi: parsing "a": invalid syntax` {
+		t.Error("Unexpected explanation:", s)
+	}
+
+	number := Some(5)
+	number.AddValidator(func(v int) bool { return v < 0 })
+	number.AddValidator(func(v int) bool { return v > 3 })
+	if s := number.Explain(); s != "rejected by validator 1" {
+		t.Error("Unexpected explanation:", s)
+	}
+	number.SetValidatorMode(ValidateAnd)
+	if s := number.Explain(); s != "" {
+		t.Error("Unexpected explanation:", s)
+	}
+
+	if s := FromSlice([]int(nil)).Explain(); s != "nil slice" {
+		t.Error("Unexpected explanation:", s)
+	}
+}
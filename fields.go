@@ -0,0 +1,103 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// anySetter is implemented by *Option of every type, for code which cannot
+// know the type parameter statically.
+type anySetter interface {
+	setAny(v any)
+}
+
+// setAny stores v, which must be of type T, as if by Swap. v may also be nil
+// if T is an interface type, which makes the Option None.
+func (o *Option[T]) setAny(v any) {
+	t, _ := v.(T)
+	o.set(t)
+}
+
+var anyOptionType = reflect.TypeFor[anyOption]()
+
+// isOptionType reports whether typ is an Option or *Option type.
+func isOptionType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && typ.Implements(anyOptionType)
+}
+
+// optionField is an exported field of type Option or *Option.
+type optionField struct {
+	reflect.StructField
+
+	// Path is the dot-separated names of the field and the struct fields
+	// containing it, and Index is relative to the outermost struct.
+	Path string
+}
+
+// optionFields returns the Option fields of struct type typ, including those
+// of its nested struct fields which are not pointers.
+func optionFields(typ reflect.Type) []optionField {
+	var fields []optionField
+	collectOptionFields(typ, nil, "", &fields)
+	return fields
+}
+
+func collectOptionFields(typ reflect.Type, index []int, prefix string, fields *[]optionField) {
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		f.Index = append(index[:len(index):len(index)], i)
+		path := prefix + f.Name
+
+		switch {
+		case isOptionType(f.Type):
+			*fields = append(*fields, optionField{f, path})
+		case f.Type.Kind() == reflect.Struct:
+			collectOptionFields(f.Type, f.Index, path+".", fields)
+		}
+	}
+}
+
+// optionElem returns the type parameter of typ, an Option or *Option type.
+func optionElem(typ reflect.Type) reflect.Type {
+	if typ.Kind() != reflect.Pointer {
+		typ = reflect.PointerTo(typ)
+	}
+	m, _ := typ.MethodByName("Unwrap")
+	return m.Type.Out(0)
+}
+
+// matchField returns the field of struct type typ corresponding to f, an
+// Option field of another struct, by its path. The field must be exported,
+// nested in struct fields which are not pointers, and have a type assignable
+// to the type parameter of f.
+func matchField(typ reflect.Type, f optionField) (reflect.StructField, error) {
+	var (
+		sf    reflect.StructField
+		index []int
+	)
+	for _, name := range strings.Split(f.Path, ".") {
+		if typ.Kind() != reflect.Struct {
+			return sf, fmt.Errorf("opzione: field %s has no counterpart in %v", f.Path, typ)
+		}
+		var ok bool
+		if sf, ok = typ.FieldByName(name); !ok || !sf.IsExported() {
+			return sf, fmt.Errorf("opzione: field %s has no counterpart in %v", f.Path, typ)
+		}
+		index = append(index, sf.Index...)
+		typ = sf.Type
+	}
+	if elem := optionElem(f.Type); !sf.Type.AssignableTo(elem) || !elem.AssignableTo(sf.Type) {
+		return sf, fmt.Errorf("opzione: field %s has type %v, which does not match %v", f.Path, sf.Type, elem)
+	}
+	sf.Index = index
+	return sf, nil
+}
@@ -0,0 +1,25 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "reflect"
+
+// Flatten returns an Option of the innermost non-pointer value, following
+// nested pointers such that Option[**T] becomes Option[T] (as any). This is
+// useful for logging and serialization layers which are interested in the
+// actual data instead of pointer chains. The result is None if the Option
+// contains no meaningful value.
+func (o *Option[T]) Flatten() *Option[any] {
+	if o.IsNone() {
+		return None[any]()
+	}
+
+	val := reflect.ValueOf(*o.v)
+	for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	if !val.IsValid() {
+		return None[any]()
+	}
+	return Some(val.Interface())
+}
@@ -0,0 +1,23 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "testing"
+
+func TestOption_Flatten(t *testing.T) {
+	number := 10
+	numptr := &number
+
+	flat := Some(&numptr).Flatten()
+	if v := flat.Unwrap(); v != 10 {
+		t.Error("Unexpected value:", v)
+	}
+
+	if flat = Some(number).Flatten(); flat.Unwrap() != 10 {
+		t.Error("Unexpected value:", flat.Unwrap())
+	}
+
+	if flat = None[**int]().Flatten(); !flat.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,42 @@
+package opzione
+
+import "fmt"
+
+// String implements fmt.Stringer, returning "Some(v)" if the Option contains
+// a meaningful value, or "None" otherwise.
+func (o Option[T]) String() string {
+	if o.IsNone() {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", *o.v)
+}
+
+// GoString implements fmt.GoStringer, returning a Go expression constructing
+// an equivalent Option.
+func (o Option[T]) GoString() string {
+	typ := typeName[T]()
+	if o.IsNone() {
+		return fmt.Sprintf("opzione.None[%v]()", typ)
+	}
+	return fmt.Sprintf("opzione.Some[%v](%#v)", typ, *o.v)
+}
+
+// typeName returns the name of T as printed by %T, without reflection.
+func typeName[T any]() string {
+	// A nil *T names T even if T is an interface type.
+	return fmt.Sprintf("%T", (*T)(nil))[1:]
+}
+
+// Format implements fmt.Formatter. The %#v verb prints the same as GoString.
+// Other verbs print "None", or "Some(v)" where v is the contained value
+// formatted with the verb and flags given.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, o.GoString())
+	case o.IsNone():
+		fmt.Fprint(f, "None")
+	default:
+		fmt.Fprintf(f, "Some(%s)", fmt.Sprintf(fmt.FormatString(f, verb), *o.v))
+	}
+}
@@ -0,0 +1,32 @@
+package opzione
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOption_Format(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	cases := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", Some(1), "Some(1)"},
+		{"%v", None[int](), "None"},
+		{"%+v", Some(point{1, 2}), "Some({X:1 Y:2})"},
+		{"%03d", S(7), "Some(007)"},
+		{"%q", S("a"), `Some("a")`},
+		{"%#v", S(1), "opzione.Some[int](1)"},
+		{"%#v", N[string](), "opzione.None[string]()"},
+		{"%s", Some("a").String(), "Some(a)"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.value); got != c.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", c.format, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package opzione
+
+import (
+	"context"
+	"sync"
+)
+
+// Future is an optional value which is resolved later by a producer, either
+// with a value by Resolve or with an error by Reject. Only the first
+// resolution takes effect. The zero value is an unresolved Future.
+type Future[T any] struct {
+	init sync.Once
+	once sync.Once
+	done chan struct{}
+	v    T
+	err  error
+}
+
+// NewFuture constructs an unresolved Future.
+func NewFuture[T any]() *Future[T] {
+	return new(Future[T])
+}
+
+// wait returns the channel which is closed once the Future is resolved,
+// allocating it on first use, so that the zero value can be used.
+func (f *Future[T]) wait() chan struct{} {
+	f.init.Do(func() {
+		f.done = make(chan struct{})
+	})
+	return f.done
+}
+
+// Resolve resolves the Future with v. It reports whether this call resolved
+// the Future, which is false if it has already been resolved or rejected.
+func (f *Future[T]) Resolve(v T) bool {
+	return f.resolve(v, nil)
+}
+
+// Reject resolves the Future with err, which results in None. It reports
+// whether this call resolved the Future.
+func (f *Future[T]) Reject(err error) bool {
+	var t T
+	if err == nil {
+		err = ErrNoneOptional
+	}
+	return f.resolve(t, err)
+}
+
+func (f *Future[T]) resolve(v T, err error) (ok bool) {
+	f.once.Do(func() {
+		f.v, f.err = v, err
+		close(f.wait())
+		ok = true
+	})
+	return
+}
+
+// Done returns a channel which is closed once the Future is resolved.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.wait()
+}
+
+// Get blocks until the Future is resolved, or ctx is done, in which case
+// it returns the error of ctx. The returned Optional is None if the Future
+// has been rejected, and its Value method returns the rejection error. Each
+// call returns a new Optional, so that callers may modify it independently.
+func (f *Future[T]) Get(ctx context.Context) (Optional[T], error) {
+	select {
+	case <-f.wait():
+		return Try(f.v, f.err), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
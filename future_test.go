@@ -0,0 +1,83 @@
+package opzione
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFuture(t *testing.T) {
+	future := NewFuture[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		future.Resolve(12)
+	}()
+
+	option, err := future.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if future.Resolve(24) || future.Reject(errors.New("late")) {
+		t.Error("Future resolved twice")
+	}
+
+	errTest := errors.New("test")
+	rejected := NewFuture[int]()
+	rejected.Reject(errTest)
+	if option, _ = rejected.Get(context.Background()); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err = option.Value(); !errors.Is(err, errTest) {
+		t.Error("Unexpected error:", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err = NewFuture[int]().Get(ctx); err != context.DeadlineExceeded {
+		t.Error("Unexpected error:", err)
+	}
+}
+
+func TestFuture_Zero(t *testing.T) {
+	var future Future[int]
+	select {
+	case <-future.Done():
+		t.Error("Unexpected resolution")
+	default:
+	}
+
+	go future.Resolve(12)
+	option, err := future.Get(context.Background())
+	if err != nil || option.Unwrap() != 12 {
+		t.Error("Unexpected result:", option, err)
+	}
+
+	var rejected Future[int]
+	if !rejected.Reject(nil) {
+		t.Error("Future not rejected")
+	}
+	<-rejected.Done()
+}
+
+func TestFuture_GetCopies(t *testing.T) {
+	future := NewFuture[int]()
+	future.Resolve(12)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			option, _ := future.Get(context.Background())
+			if v, err := option.Take(); err != nil || *v != 12 {
+				t.Error("Unexpected result:", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
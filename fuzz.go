@@ -0,0 +1,146 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Mode is a way in which an Option decides whether its value is nil, as
+// chosen by the constructors according to the type of the value.
+type Mode int
+
+const (
+	// ModeValue is used for types which cannot be nil, such as numbers,
+	// strings, structs and slices.
+	ModeValue Mode = iota
+
+	// ModeDirect is used for single references, such as pointers to values,
+	// maps, channels and functions, which are compared to nil directly.
+	ModeDirect
+
+	// ModeTracked is used for nested references, such as pointers to
+	// pointers, which are followed to the innermost reference.
+	ModeTracked
+
+	// ModeInterface is used for interface types, whose dynamic value is
+	// checked whenever it changes.
+	ModeInterface
+)
+
+// NewForKind constructs an optional whose value type is of the given kind,
+// containing a value if some is true. It is intended for fuzzing generic
+// code against the internal modes the package distinguishes. The mode is
+// the one the constructors use for values of the kind, where pointers are
+// pointers to pointers, so that they are tracked; NewForMode constructs
+// optionals in the other modes. It returns an error if kind is not
+// supported.
+func NewForKind(kind reflect.Kind, some bool) (AnyOptional, error) {
+	mode := ModeValue
+	switch kind {
+	case reflect.Pointer:
+		mode = ModeTracked
+	case reflect.UnsafePointer, reflect.Func, reflect.Map, reflect.Chan:
+		mode = ModeDirect
+	case reflect.Interface:
+		mode = ModeInterface
+	}
+	return NewForMode(kind, mode, some)
+}
+
+// NewForMode is like NewForKind, but constructs an optional checked in the
+// given mode. Values of every kind can be checked in ModeInterface, stored in
+// an Option[any]; pointers can be checked in ModeDirect and ModeTracked. It
+// returns an error if kind cannot be checked in mode.
+func NewForMode(kind reflect.Kind, mode Mode, some bool) (AnyOptional, error) {
+	switch kind {
+	case reflect.Bool:
+		return newForValue(kind, ModeValue, mode, true, some)
+	case reflect.Int:
+		return newForValue(kind, ModeValue, mode, 1, some)
+	case reflect.Int8:
+		return newForValue(kind, ModeValue, mode, int8(1), some)
+	case reflect.Int16:
+		return newForValue(kind, ModeValue, mode, int16(1), some)
+	case reflect.Int32:
+		return newForValue(kind, ModeValue, mode, int32(1), some)
+	case reflect.Int64:
+		return newForValue(kind, ModeValue, mode, int64(1), some)
+	case reflect.Uint:
+		return newForValue(kind, ModeValue, mode, uint(1), some)
+	case reflect.Uint8:
+		return newForValue(kind, ModeValue, mode, uint8(1), some)
+	case reflect.Uint16:
+		return newForValue(kind, ModeValue, mode, uint16(1), some)
+	case reflect.Uint32:
+		return newForValue(kind, ModeValue, mode, uint32(1), some)
+	case reflect.Uint64:
+		return newForValue(kind, ModeValue, mode, uint64(1), some)
+	case reflect.Uintptr:
+		return newForValue(kind, ModeValue, mode, uintptr(1), some)
+	case reflect.Float32:
+		return newForValue(kind, ModeValue, mode, float32(1), some)
+	case reflect.Float64:
+		return newForValue(kind, ModeValue, mode, float64(1), some)
+	case reflect.Complex64:
+		return newForValue(kind, ModeValue, mode, complex64(1), some)
+	case reflect.Complex128:
+		return newForValue(kind, ModeValue, mode, complex128(1), some)
+	case reflect.String:
+		return newForValue(kind, ModeValue, mode, "1", some)
+	case reflect.Array:
+		return newForValue(kind, ModeValue, mode, [1]int{1}, some)
+	case reflect.Struct:
+		return newForValue(kind, ModeValue, mode, struct{ v int }{1}, some)
+	case reflect.Slice:
+		return newForValue(kind, ModeValue, mode, []int{1}, some)
+	case reflect.Pointer:
+		i := 1
+		p := &i
+		switch mode {
+		case ModeDirect:
+			return newForKind(p, some), nil
+		case ModeTracked:
+			return newForKind(&p, some), nil
+		}
+		return newForValue(kind, ModeDirect, mode, p, some)
+	case reflect.UnsafePointer:
+		i := 1
+		return newForValue(kind, ModeDirect, mode, unsafe.Pointer(&i), some)
+	case reflect.Func:
+		return newForValue(kind, ModeDirect, mode, func() {}, some)
+	case reflect.Map:
+		return newForValue(kind, ModeDirect, mode, map[int]int{1: 1}, some)
+	case reflect.Chan:
+		return newForValue(kind, ModeDirect, mode, make(chan int), some)
+	case reflect.Interface:
+		if mode != ModeInterface {
+			break
+		}
+		return newForKind(errors.New("1"), some), nil
+	}
+	return nil, fmt.Errorf("opzione: unsupported kind %v in mode %d", kind, mode)
+}
+
+// newForValue constructs an optional of v, which is of kind, in mode if it
+// is either native, the mode of the type of v, or ModeInterface.
+func newForValue[T any](kind reflect.Kind, native, mode Mode, v T, some bool) (AnyOptional, error) {
+	switch mode {
+	case native:
+		return newForKind(v, some), nil
+	case ModeInterface:
+		return newForKind[any](v, some), nil
+	default:
+		return nil, fmt.Errorf("opzione: unsupported kind %v in mode %d", kind, mode)
+	}
+}
+
+func newForKind[T any](v T, some bool) AnyOptional {
+	if some {
+		return Some(v)
+	}
+	return None[T]()
+}
@@ -0,0 +1,92 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"reflect"
+	"testing"
+)
+
+// modeOf returns the mode option is checked in, judging by its flags.
+func modeOf(option AnyOptional) Mode {
+	v := reflect.ValueOf(option).Elem()
+	switch {
+	case optionElem(v.Type()).Kind() == reflect.Interface:
+		return ModeInterface
+	case v.FieldByName("direct").Bool():
+		return ModeDirect
+	case v.FieldByName("track").Bool():
+		return ModeTracked
+	default:
+		return ModeValue
+	}
+}
+
+func TestNewForKind(t *testing.T) {
+	for kind := reflect.Bool; kind <= reflect.UnsafePointer; kind++ {
+		option, err := NewForKind(kind, true)
+		if err != nil {
+			t.Fatal(kind, err)
+		}
+		if option.IsNone() {
+			t.Error("Unexpected None:", kind)
+		}
+		option, err = NewForKind(kind, false)
+		if err != nil {
+			t.Fatal(kind, err)
+		}
+		if !option.IsNone() {
+			t.Error("Unexpected Some:", kind)
+		}
+	}
+
+	for kind, mode := range map[reflect.Kind]Mode{
+		reflect.Int:       ModeValue,
+		reflect.Slice:     ModeValue,
+		reflect.Pointer:   ModeTracked,
+		reflect.Map:       ModeDirect,
+		reflect.Interface: ModeInterface,
+	} {
+		if option, _ := NewForKind(kind, true); modeOf(option) != mode {
+			t.Error("Unexpected mode:", kind, modeOf(option))
+		}
+	}
+
+	if _, err := NewForKind(reflect.Invalid, true); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestNewForMode(t *testing.T) {
+	for _, mode := range []Mode{ModeDirect, ModeTracked, ModeInterface} {
+		for _, some := range []bool{true, false} {
+			option, err := NewForMode(reflect.Pointer, mode, some)
+			if err != nil {
+				t.Fatal(mode, err)
+			}
+			if modeOf(option) != mode {
+				t.Error("Unexpected mode:", mode, modeOf(option))
+			}
+			if option.IsNone() == some {
+				t.Error("Unexpected result:", mode, some)
+			}
+		}
+	}
+
+	for kind := reflect.Bool; kind <= reflect.UnsafePointer; kind++ {
+		option, err := NewForMode(kind, ModeInterface, true)
+		if err != nil {
+			t.Fatal(kind, err)
+		}
+		if modeOf(option) != ModeInterface || option.IsNone() {
+			t.Error("Unexpected result:", kind)
+		}
+	}
+
+	if _, err := NewForMode(reflect.Int, ModeDirect, true); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if _, err := NewForMode(reflect.Interface, ModeTracked, true); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
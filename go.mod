@@ -1,3 +1,8 @@
 module github.com/oissevalt/opzione
 
-go 1.22
+go 1.23
+
+require (
+	github.com/google/go-cmp v0.7.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,14 @@
+module github.com/oissevalt/opzione/gormopt
+
+go 1.23
+
+require (
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
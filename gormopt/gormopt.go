@@ -0,0 +1,118 @@
+// Package gormopt provides optional values which can be stored by
+// gorm.io/gorm. It is a separate module, so that opzione does not depend on
+// GORM.
+package gormopt
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/oissevalt/opzione"
+	"gorm.io/gorm/schema"
+)
+
+// Serializer is the name under which the GORM serializer for Option is
+// registered. Option fields must be tagged with it, since the Option cannot
+// be used as a driver.Valuer by itself:
+//
+//	type User struct {
+//		ID   uint
+//		Name gormopt.Option[string] `gorm:"serializer:opzione"`
+//	}
+//
+// Such fields are stored as NULL if None, and are scanned into None from
+// NULL. Unless the field is tagged with "not null", migrations create
+// nullable columns of the type reported by GormDataType. The serializer
+// accepts opzione.Option fields as well, for which GORM cannot tell the
+// column type.
+const Serializer = "opzione"
+
+func init() {
+	schema.RegisterSerializer(Serializer, serializer{})
+}
+
+// Option is an opzione.Option which reports the GORM data type of T.
+type Option[T any] struct {
+	opzione.Option[T]
+}
+
+// Some returns an Option containing v, panicking as opzione.Some does if v
+// is nil.
+func Some[T any](v T) Option[T] {
+	return Option[T]{opzione.S(v)}
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{opzione.N[T]()}
+}
+
+// GormDataType implements schema.GormDataTypeInterface, reporting the GORM
+// data type of T, so that migrations create columns as they would for T. If
+// T implements schema.GormDataTypeInterface itself, its data type is used.
+// Other types are reported as strings, as GORM does for serialized fields.
+func (Option[T]) GormDataType() string {
+	var t T
+	if dt, ok := any(&t).(schema.GormDataTypeInterface); ok {
+		return dt.GormDataType()
+	}
+
+	typ := reflect.TypeFor[T]()
+	switch typ.Kind() {
+	case reflect.Bool:
+		return string(schema.Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return string(schema.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return string(schema.Uint)
+	case reflect.Float32, reflect.Float64:
+		return string(schema.Float)
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return string(schema.Bytes)
+		}
+	case reflect.Struct:
+		if typ.ConvertibleTo(reflect.TypeFor[time.Time]()) {
+			return string(schema.Time)
+		}
+	}
+	return string(schema.String)
+}
+
+type serializer struct{}
+
+// Scan scans dbValue into the Option field of dst, allocating the Option if
+// the field is a pointer.
+func (serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	v := reflect.New(field.IndirectFieldType)
+	s, ok := v.Interface().(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("gormopt: cannot scan into field %s of type %v", field.Name, field.FieldType)
+	}
+	if err := s.Scan(dbValue); err != nil {
+		return err
+	}
+
+	if field.FieldType.Kind() != reflect.Pointer {
+		v = v.Elem()
+	}
+	field.ReflectValueOf(ctx, dst).Set(v)
+	return nil
+}
+
+// Value returns the driver value of the Option, which is NULL if the Option
+// is None or a nil pointer.
+func (serializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	if rv := reflect.ValueOf(fieldValue); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	v, ok := fieldValue.(interface{ Valuer() driver.Valuer })
+	if !ok {
+		return nil, fmt.Errorf("gormopt: cannot serialize field %s of type %v", field.Name, field.FieldType)
+	}
+	return v.Valuer().Value()
+}
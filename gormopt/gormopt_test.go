@@ -0,0 +1,120 @@
+package gormopt
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oissevalt/opzione"
+	"gorm.io/gorm/schema"
+)
+
+// Interface assertions
+var (
+	_ schema.GormDataTypeInterface = Option[int]{}
+	_ schema.SerializerInterface   = serializer{}
+)
+
+func TestOption_GormDataType(t *testing.T) {
+	type record struct {
+		ID      uint
+		Name    Option[string]          `gorm:"serializer:opzione"`
+		Age     *Option[int]            `gorm:"serializer:opzione"`
+		Score   Option[float64]         `gorm:"serializer:opzione"`
+		Born    Option[time.Time]       `gorm:"serializer:opzione"`
+		Avatar  Option[[]byte]          `gorm:"serializer:opzione"`
+		Version Option[uint16]          `gorm:"serializer:opzione;not null"`
+		Meta    Option[struct{ A int }] `gorm:"serializer:opzione"`
+	}
+
+	s, err := schema.Parse(&record{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]schema.DataType{
+		"name":    schema.String,
+		"age":     schema.Int,
+		"score":   schema.Float,
+		"born":    schema.Time,
+		"avatar":  schema.Bytes,
+		"version": schema.Uint,
+		"meta":    schema.String,
+	}
+	for name, dt := range want {
+		f := s.LookUpField(name)
+		if f == nil {
+			t.Error("Unexpected missing field:", name)
+			continue
+		}
+		if f.DataType != dt {
+			t.Error("Unexpected data type:", name, f.DataType)
+		}
+		if f.Serializer == nil {
+			t.Error("Unexpected missing serializer:", name)
+		}
+		if f.NotNull != (name == "version") {
+			t.Error("Unexpected nullability:", name, f.NotNull)
+		}
+	}
+}
+
+func TestSerializer(t *testing.T) {
+	type record struct {
+		Name Option[string]         `gorm:"serializer:opzione"`
+		Age  *Option[int64]         `gorm:"serializer:opzione"`
+		Nick opzione.Option[string] `gorm:"serializer:opzione"`
+	}
+
+	var (
+		ctx = context.Background()
+		ser serializer
+	)
+	s, err := schema.Parse(&record{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, age, nick := s.LookUpField("name"), s.LookUpField("age"), s.LookUpField("nick")
+
+	var r record
+	dst := reflect.ValueOf(&r).Elem()
+	if err = ser.Scan(ctx, name, dst, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := r.Name.Value(); err != nil || v != "alice" {
+		t.Error("Unexpected scanned value:", v, err)
+	}
+	if err = ser.Scan(ctx, age, dst, int64(42)); err != nil {
+		t.Fatal(err)
+	}
+	if r.Age == nil || r.Age.Unwrap() != 42 {
+		t.Error("Unexpected scanned pointer:", r.Age)
+	}
+	if err = ser.Scan(ctx, name, dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Name.IsNone() {
+		t.Error("Unexpected value scanned from NULL:", r.Name)
+	}
+	if err = ser.Scan(ctx, nick, dst, "al"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := ser.Value(ctx, nick, dst, r.Nick); err != nil || v != "al" {
+		t.Error("Unexpected driver value:", v, err)
+	}
+
+	if v, err := ser.Value(ctx, age, dst, r.Age); err != nil || v != int64(42) {
+		t.Error("Unexpected driver value:", v, err)
+	}
+	if v, err := ser.Value(ctx, name, dst, r.Name); err != nil || v != nil {
+		t.Error("Unexpected driver value for None:", v, err)
+	}
+	if v, err := ser.Value(ctx, age, dst, (*Option[int64])(nil)); err != nil || v != nil {
+		t.Error("Unexpected driver value for nil:", v, err)
+	}
+	if _, err := ser.Value(ctx, name, dst, "alice"); err == nil {
+		t.Error("Unexpected nil error for non-Option value")
+	}
+}
@@ -0,0 +1,149 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// QueryString retrieves the first value of the query parameter named by key,
+// returning None if the parameter is not present.
+func QueryString(r *http.Request, key string) *Option[string] {
+	return lookupValues(r.URL.Query(), key, parseString)
+}
+
+// QueryInt retrieves the query parameter named by key as an int, returning
+// None if the parameter is not present or cannot be parsed.
+func QueryInt(r *http.Request, key string) *Option[int] {
+	return lookupValues(r.URL.Query(), key, strconv.Atoi)
+}
+
+// QueryInt64 retrieves the query parameter named by key as an int64,
+// returning None if the parameter is not present or cannot be parsed.
+func QueryInt64(r *http.Request, key string) *Option[int64] {
+	return lookupValues(r.URL.Query(), key, parseInt64)
+}
+
+// QueryFloat64 retrieves the query parameter named by key as a float64,
+// returning None if the parameter is not present or cannot be parsed.
+func QueryFloat64(r *http.Request, key string) *Option[float64] {
+	return lookupValues(r.URL.Query(), key, parseFloat64)
+}
+
+// QueryBool retrieves the query parameter named by key as a bool, as parsed
+// by strconv.ParseBool, returning None if the parameter is not present or
+// cannot be parsed.
+func QueryBool(r *http.Request, key string) *Option[bool] {
+	return lookupValues(r.URL.Query(), key, strconv.ParseBool)
+}
+
+// QueryDuration retrieves the query parameter named by key as a
+// time.Duration, as parsed by time.ParseDuration, returning None if the
+// parameter is not present or cannot be parsed.
+func QueryDuration(r *http.Request, key string) *Option[time.Duration] {
+	return lookupValues(r.URL.Query(), key, time.ParseDuration)
+}
+
+// QueryTime retrieves the query parameter named by key as a time.Time in
+// layout, as parsed by time.Parse, returning None if the parameter is not
+// present or cannot be parsed.
+func QueryTime(r *http.Request, key, layout string) *Option[time.Time] {
+	return lookupValues(r.URL.Query(), key, parseTime(layout))
+}
+
+// FormString retrieves the first value of the form field named by key, as
+// http.Request.FormValue does, returning None if the field is not present in
+// the form or the query.
+func FormString(r *http.Request, key string) *Option[string] {
+	return lookupValues(form(r), key, parseString)
+}
+
+// FormInt is like FormString, but parses the value as an int, returning None
+// if it cannot be parsed.
+func FormInt(r *http.Request, key string) *Option[int] {
+	return lookupValues(form(r), key, strconv.Atoi)
+}
+
+// FormInt64 is like FormString, but parses the value as an int64, returning
+// None if it cannot be parsed.
+func FormInt64(r *http.Request, key string) *Option[int64] {
+	return lookupValues(form(r), key, parseInt64)
+}
+
+// FormFloat64 is like FormString, but parses the value as a float64,
+// returning None if it cannot be parsed.
+func FormFloat64(r *http.Request, key string) *Option[float64] {
+	return lookupValues(form(r), key, parseFloat64)
+}
+
+// FormBool is like FormString, but parses the value as a bool, as parsed by
+// strconv.ParseBool, returning None if it cannot be parsed.
+func FormBool(r *http.Request, key string) *Option[bool] {
+	return lookupValues(form(r), key, strconv.ParseBool)
+}
+
+// FormDuration is like FormString, but parses the value as a time.Duration,
+// as parsed by time.ParseDuration, returning None if it cannot be parsed.
+func FormDuration(r *http.Request, key string) *Option[time.Duration] {
+	return lookupValues(form(r), key, time.ParseDuration)
+}
+
+// FormTime is like FormString, but parses the value as a time.Time in
+// layout, as parsed by time.Parse, returning None if it cannot be parsed.
+func FormTime(r *http.Request, key, layout string) *Option[time.Time] {
+	return lookupValues(form(r), key, parseTime(layout))
+}
+
+// Header retrieves the first value of the header named by key, which is
+// canonicalized as http.Header.Get does, returning None if the header is not
+// present.
+func Header(h http.Header, key string) *Option[string] {
+	vs := h.Values(key)
+	if len(vs) == 0 {
+		return None[string]()
+	}
+	return Some(vs[0])
+}
+
+// Cookie retrieves the cookie named by name from r, returning None if it is
+// not present.
+func Cookie(r *http.Request, name string) *Option[*http.Cookie] {
+	return Try(r.Cookie(name))
+}
+
+// form returns the parsed form of r, parsing it as FormValue does.
+func form(r *http.Request) url.Values {
+	if r.Form == nil {
+		r.FormValue("")
+	}
+	return r.Form
+}
+
+func lookupValues[T any](values url.Values, key string, parse func(string) (T, error)) *Option[T] {
+	vs := values[key]
+	if len(vs) == 0 {
+		return None[T]()
+	}
+	return Try(parse(vs[0]))
+}
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func parseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func parseFloat64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseTime(layout string) func(string) (time.Time, error) {
+	return func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	}
+}
@@ -0,0 +1,85 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	r := httptest.NewRequest("GET", "/?s=&i=12&i64=x&f=1.5&b=true&d=1m&t=2024-01-01", nil)
+
+	if option := QueryString(r, "s"); option.IsNone() || option.Unwrap() != "" {
+		t.Error("Unexpected None")
+	}
+	if option := QueryString(r, "missing"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := QueryInt(r, "i"); option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := QueryInt64(r, "i64"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := QueryFloat64(r, "f"); option.Unwrap() != 1.5 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := QueryBool(r, "b"); !option.Unwrap() {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := QueryDuration(r, "d"); option.Unwrap() != time.Minute {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := QueryTime(r, "t", time.DateOnly); option.Unwrap().Year() != 2024 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+}
+
+func TestForm(t *testing.T) {
+	body := url.Values{"i": {"3"}, "t": {"x"}}.Encode()
+	r := httptest.NewRequest("POST", "/?q=1", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if option := FormInt(r, "i"); option.Unwrap() != 3 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := FormInt64(r, "q"); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := FormTime(r, "t", time.DateOnly); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := FormString(r, "missing"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestHeaderCookie(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("X-Empty", "")
+	r.Header.Set("X-Request-Id", "1")
+	r.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+
+	if option := Header(r.Header, "x-request-id"); option.Unwrap() != "1" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := Header(r.Header, "X-Empty"); option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	if option := Header(r.Header, "X-Missing"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if option := Cookie(r, "session"); option.Unwrap().Value != "abc" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if _, err := Cookie(r, "missing").Value(); !errors.Is(err, http.ErrNoCookie) {
+		t.Error("Unexpected error:", err)
+	}
+}
@@ -0,0 +1,96 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DecodeNext decodes the next JSON value from dec into an Option, which is
+// None if the value is null. At the end of the stream, it returns io.EOF as
+// it is, like dec.Decode does, so that streams can be read with a simple
+// loop:
+//
+//	for {
+//		option, err := opzione.DecodeNext[T](dec)
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+//
+// Elements of an array can be read likewise, checking dec.More instead.
+func DecodeNext[T any](dec *json.Decoder) (*Option[T], error) {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return None[T](), nil
+	}
+
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return FromPointer(&v), nil
+}
+
+// IsZero reports whether the Option contains no meaningful value, so that
+// None fields tagged with omitzero are omitted by encoding/json.
+func (o Option[T]) IsZero() bool {
+	return o.IsNone()
+}
+
+// MarshalJSON implements json.Marshaler. The contained value is marshalled
+// as it is, and an Option containing no meaningful value is marshalled as
+// null.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*o.v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A null value results in None.
+// Absent struct fields are left untouched by encoding/json, and a zero
+// Option is None.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		o.unset()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.set(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Unset and null are both marshalled
+// as null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != nullableSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A null value makes the Nullable
+// null, and other values set it.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = NullableNull[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NullableSome(v)
+	return nil
+}
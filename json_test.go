@@ -0,0 +1,158 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecodeNext(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader("1 null 3"))
+
+	var values []int
+	nones := 0
+	for {
+		option, err := DecodeNext[int](dec)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if option.IsNone() {
+			nones++
+			continue
+		}
+		values = append(values, option.Unwrap())
+	}
+	if len(values) != 2 || values[0] != 1 || values[1] != 3 || nones != 1 {
+		t.Error("Unexpected result:", values, nones)
+	}
+
+	if option, err := DecodeNext[int](dec); err != io.EOF || option != nil {
+		t.Error("Unexpected result at EOF:", option, err)
+	}
+
+	dec = json.NewDecoder(strings.NewReader("[1, null]"))
+	if _, err := dec.Token(); err != nil {
+		t.Fatal(err)
+	}
+	nones = 0
+	for dec.More() {
+		option, err := DecodeNext[int](dec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if option.IsNone() {
+			nones++
+		}
+	}
+	if nones != 1 {
+		t.Error("Unexpected number of None:", nones)
+	}
+
+	dec = json.NewDecoder(strings.NewReader(`"string"`))
+	if _, err := DecodeNext[int](dec); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestOption_JSON(t *testing.T) {
+	type model struct {
+		Name  Option[string]   `json:"name"`
+		Count *Option[int]     `json:"count"`
+		Tags  Option[[]string] `json:"tags"`
+	}
+
+	data, err := json.Marshal(model{Name: S("a"), Count: None[int](), Tags: N[[]string]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"a","count":null,"tags":null}` {
+		t.Error("Unexpected JSON:", string(data))
+	}
+
+	var m model
+	if err = json.Unmarshal([]byte(`{"name":null,"count":3}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Name.IsNone() || m.Count.Unwrap() != 3 || !m.Tags.IsNone() {
+		t.Error("Unexpected result:", m)
+	}
+
+	if err = json.Unmarshal([]byte(`{"count":"3"}`), &m); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestOption_IsZero(t *testing.T) {
+	type model struct {
+		Name  Option[string] `json:"name,omitzero"`
+		Count Option[int]    `json:"count"`
+	}
+
+	data, err := json.Marshal(model{Name: N[string](), Count: N[int]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"count":null}` {
+		t.Error("Unexpected JSON:", string(data))
+	}
+}
+
+func TestNullable_JSON(t *testing.T) {
+	type body struct {
+		Name  Nullable[string] `json:"name,omitzero"`
+		Email Nullable[string] `json:"email,omitzero"`
+		Phone Nullable[string] `json:"phone,omitzero"`
+	}
+
+	var b body
+	if err := json.Unmarshal([]byte(`{"name":"a","email":null}`), &b); err != nil {
+		t.Fatal(err)
+	}
+	if !b.Name.IsSet() || !b.Email.IsNull() || !b.Phone.IsUnset() {
+		t.Error("Unexpected states:", b)
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil || string(data) != `{"name":"a","email":null}` {
+		t.Error("Unexpected JSON:", string(data), err)
+	}
+}
+
+func TestOption_HooksDecoding(t *testing.T) {
+	var somes, nones int
+	var option Option[int]
+	option.OnSome(func(int) { somes++ })
+	option.OnNone(func() { nones++ })
+
+	if err := json.Unmarshal([]byte("1"), &option); err != nil {
+		t.Fatal(err)
+	}
+	if err := option.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := option.Scan(int64(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte("null"), &option); err != nil {
+		t.Fatal(err)
+	}
+	if somes != 2 || nones != 2 {
+		t.Error("Unexpected transitions:", somes, nones)
+	}
+}
+
+func TestFromSlice_JSON(t *testing.T) {
+	option := FromSlice([]int{})
+	if err := option.UnmarshalJSON([]byte("[1]")); err != nil || len(option.Unwrap()) != 1 {
+		t.Error("Unexpected result:", err)
+	}
+	if err := option.UnmarshalJSON([]byte("null")); err != nil || !option.IsNone() {
+		t.Error("Unexpected result:", err)
+	}
+}
@@ -0,0 +1,91 @@
+package opzione
+
+import (
+	"iter"
+	"sync"
+)
+
+// Lazy is an optional value computed by a function on first access, exactly
+// once, after which it behaves as Some if the function succeeded, or None
+// if it returned an error. The computation is safe for concurrent use, but
+// like Option, a Lazy must not be modified concurrently afterwards.
+type Lazy[T any] struct {
+	once sync.Once
+	init func() (T, error)
+	opt  *Option[T]
+}
+
+// NewLazy constructs a Lazy whose value is computed by f.
+func NewLazy[T any](f func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{init: f}
+}
+
+func (l *Lazy[T]) get() *Option[T] {
+	l.once.Do(func() {
+		l.opt = Try(l.init())
+		l.init = nil
+	})
+	return l.opt
+}
+
+// IsNone reports whether the computed value is not meaningful.
+func (l *Lazy[T]) IsNone() bool {
+	return l.get().IsNone()
+}
+
+// IsSome reports whether the computed value is meaningful.
+func (l *Lazy[T]) IsSome() bool {
+	return l.get().IsSome()
+}
+
+// Value returns the computed value. If the computation failed, the error
+// returned wraps both ErrNoneOptional and the error of the computation.
+func (l *Lazy[T]) Value() (T, error) {
+	return l.get().Value()
+}
+
+// Get returns the computed value, and whether it is meaningful.
+func (l *Lazy[T]) Get() (T, bool) {
+	return l.get().Get()
+}
+
+// Unwrap returns the computed value, panicking if it is not meaningful.
+func (l *Lazy[T]) Unwrap() T {
+	return l.get().Unwrap()
+}
+
+// Swap replaces the computed value with v, returning the original value,
+// which is the zero value if there is none, as described by Optional.
+func (l *Lazy[T]) Swap(v T) (t T) {
+	o := l.get()
+	if o.v != nil {
+		t = *o.v
+	}
+	o.set(v)
+	return
+}
+
+// Take moves the computed value out, leaving the Lazy in a "none" state.
+func (l *Lazy[T]) Take() (*T, error) {
+	return l.get().Take()
+}
+
+// With executes f with the computed value, if it is meaningful.
+func (l *Lazy[T]) With(f func(T)) {
+	l.get().With(f)
+}
+
+// WithNone executes f if the computed value is not meaningful.
+func (l *Lazy[T]) WithNone(f func()) {
+	l.get().WithNone(f)
+}
+
+// Assign assigns the computed value to *p, if it is meaningful.
+func (l *Lazy[T]) Assign(p **T) bool {
+	return l.get().Assign(p)
+}
+
+// Iter returns an iterator yielding the computed value, if it is meaningful.
+func (l *Lazy[T]) Iter() iter.Seq[T] {
+	return l.get().Iter()
+}
@@ -0,0 +1,49 @@
+package opzione
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// Interface assertions
+var _ Optional[int] = &Lazy[int]{}
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	lazy := NewLazy(func() (int, error) {
+		calls++
+		return 12, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := lazy.Unwrap(); v != 12 {
+				t.Error("Unexpected value:", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Error("Unexpected number of calls:", calls)
+	}
+
+	errTest := errors.New("test")
+	failed := NewLazy(func() (int, error) {
+		return 0, errTest
+	})
+	if !failed.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err := failed.Value(); !errors.Is(err, errTest) {
+		t.Error("Unexpected error:", err)
+	}
+
+	failed.Swap(1)
+	if failed.Unwrap() != 1 {
+		t.Error("Unexpected value:", failed.Unwrap())
+	}
+}
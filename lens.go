@@ -0,0 +1,53 @@
+package opzione
+
+// Lens focuses on a part A of a whole S, such as a field of a struct, which
+// may be unreachable if it is behind a nil pointer. Lenses can be composed
+// to access deeply nested data safely:
+//
+//	city := opzione.Compose(
+//		opzione.NewLens(func(u *User) *Address { return u.Address }),
+//		opzione.NewLens(func(a *Address) *string { return &a.City }),
+//	)
+//	city.Get(&user) // None if user.Address is nil
+type Lens[S, A any] struct {
+	focus func(*S) *A
+}
+
+// NewLens constructs a Lens with focus, which returns a pointer to the part
+// of s, or nil if it is unreachable. s is never nil.
+func NewLens[S, A any](focus func(s *S) *A) Lens[S, A] {
+	return Lens[S, A]{focus: focus}
+}
+
+// Compose returns a Lens focusing on the part B of the part A of S.
+func Compose[S, A, B any](outer Lens[S, A], inner Lens[A, B]) Lens[S, B] {
+	return NewLens(func(s *S) *B {
+		a := outer.focus(s)
+		if a == nil {
+			return nil
+		}
+		return inner.focus(a)
+	})
+}
+
+// Get returns an Option of the part of s, which is None if s is nil, or the
+// part is unreachable, nil or dereferences to nil.
+func (l Lens[S, A]) Get(s *S) *Option[A] {
+	if s == nil {
+		return None[A]()
+	}
+	return FromPointer(l.focus(s))
+}
+
+// Set stores a as the part of s, reporting whether it is reachable.
+func (l Lens[S, A]) Set(s *S, a A) bool {
+	if s == nil {
+		return false
+	}
+	p := l.focus(s)
+	if p == nil {
+		return false
+	}
+	*p = a
+	return true
+}
@@ -0,0 +1,36 @@
+package opzione
+
+import "testing"
+
+type lensAddress struct {
+	City   string
+	Labels map[string]string
+}
+
+type lensUser struct {
+	Name    string
+	Address *lensAddress
+	Phones  []string
+	Manager *Option[*lensUser]
+	secret  string
+}
+
+func TestLens(t *testing.T) {
+	city := Compose(
+		NewLens(func(u *lensUser) *lensAddress { return u.Address }),
+		NewLens(func(a *lensAddress) *string { return &a.City }),
+	)
+
+	var user lensUser
+	if !city.Get(&user).IsNone() || !city.Get(nil).IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if city.Set(&user, "Rome") {
+		t.Error("Unexpected Set through nil pointer")
+	}
+
+	user.Address = &lensAddress{}
+	if !city.Set(&user, "Rome") || city.Get(&user).Unwrap() != "Rome" {
+		t.Error("Unexpected city:", user.Address.City)
+	}
+}
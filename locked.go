@@ -0,0 +1,189 @@
+package opzione
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// LockedOption is an Option protected by a sync.RWMutex, so that it can be
+// shared by multiple goroutines. Methods reading the Option take a read lock,
+// and methods modifying it take a write lock. The closures given to With,
+// WithNone and Mutate, as well as the hooks registered by OnSome and OnNone
+// on the underlying Option, run while the lock is held, and must not call
+// methods of the same LockedOption.
+//
+// The zero value of LockedOption contains no value.
+type LockedOption[T any] struct {
+	mu  sync.RWMutex
+	opt Option[T]
+
+	// changed is closed and cleared on every modification, waking up the
+	// goroutines blocked in WaitSome.
+	changed chan struct{}
+}
+
+// NewLocked constructs a LockedOption containing v. Like Some, it panics
+// if v is a nil pointer or a nested pointer to nil.
+func NewLocked[T any](v T) *LockedOption[T] {
+	return &LockedOption[T]{opt: *Some(v)}
+}
+
+// IsNone reports whether the LockedOption contains no meaningful value.
+func (l *LockedOption[T]) IsNone() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.opt.IsNone()
+}
+
+// IsSome reports whether the LockedOption contains a meaningful value.
+func (l *LockedOption[T]) IsSome() bool {
+	return !l.IsNone()
+}
+
+// Value attempts to retrieve the contained value, returning ErrNoneOptional
+// if there is none.
+func (l *LockedOption[T]) Value() (T, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.opt.Value()
+}
+
+// Get returns the contained value, and whether it is meaningful.
+func (l *LockedOption[T]) Get() (T, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.opt.Get()
+}
+
+// Unwrap returns the contained value, panicking if there is none.
+func (l *LockedOption[T]) Unwrap() T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.opt.Unwrap()
+}
+
+// Swap stores v and returns the previous value, which is the zero value if
+// the LockedOption contained no value, as described by Optional.
+func (l *LockedOption[T]) Swap(v T) (t T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.opt.v != nil {
+		t = *l.opt.v
+	}
+	l.opt.set(v)
+	l.broadcast()
+	return
+}
+
+// Take moves the contained value out, leaving the LockedOption in a "none"
+// state. It returns ErrNoneOptional if there is no meaningful value.
+func (l *LockedOption[T]) Take() (*T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	defer l.broadcast()
+	return l.opt.Take()
+}
+
+// CompareAndSwap stores new if the LockedOption contains a meaningful value
+// equal to old, reporting whether new has been stored. The type of values
+// must be comparable, or CompareAndSwap panics; use CompareAndSwapFunc for
+// other types.
+func (l *LockedOption[T]) CompareAndSwap(old, new T) bool {
+	return l.CompareAndSwapFunc(old, new, equal[T])
+}
+
+// CompareAndSwapFunc is like CompareAndSwap, but uses eq to compare values.
+func (l *LockedOption[T]) CompareAndSwapFunc(old, new T, eq func(a, b T) bool) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.opt.IsNone() || !eq(*l.opt.v, old) {
+		return false
+	}
+	l.opt.set(new)
+	l.broadcast()
+	return true
+}
+
+// With executes f with the contained value, if any, holding the read lock.
+func (l *LockedOption[T]) With(f func(T)) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	l.opt.With(f)
+}
+
+// WithNone executes f if the LockedOption contains no value, holding the
+// read lock.
+func (l *LockedOption[T]) WithNone(f func()) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	l.opt.WithNone(f)
+}
+
+// Assign assigns the contained value to *p, if there is one. It reports
+// whether an assignment is made. The assigned pointer refers to the value
+// inside the LockedOption, which is not protected by the lock.
+func (l *LockedOption[T]) Assign(p **T) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.opt.Assign(p)
+}
+
+// Iter returns an iterator yielding the contained value, if there is one at
+// the time of iteration. The lock is not held while yielding.
+func (l *LockedOption[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t, err := l.Value(); err == nil {
+			yield(t)
+		}
+	}
+}
+
+// Mutate executes f with the underlying Option, holding the write lock,
+// so that f can inspect and modify it atomically.
+//
+//	client.Mutate(func(o *opzione.Option[*Client]) {
+//		if o.IsNone() {
+//			o.Swap(dial())
+//		}
+//	})
+func (l *LockedOption[T]) Mutate(f func(o *Option[T])) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.opt)
+	l.broadcast()
+}
+
+// WaitSome blocks until the LockedOption contains a meaningful value, which
+// it returns, or until ctx is done, in which case it returns the error of ctx.
+func (l *LockedOption[T]) WaitSome(ctx context.Context) (T, error) {
+	for {
+		l.mu.Lock()
+		if !l.opt.IsNone() {
+			v := *l.opt.v
+			l.mu.Unlock()
+			return v, nil
+		}
+		if l.changed == nil {
+			l.changed = make(chan struct{})
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var t T
+			return t, ctx.Err()
+		}
+	}
+}
+
+// broadcast wakes up waiters after a modification. The write lock must be
+// held.
+func (l *LockedOption[T]) broadcast() {
+	if l.changed != nil {
+		close(l.changed)
+		l.changed = nil
+	}
+}
@@ -0,0 +1,90 @@
+package opzione
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Interface assertions
+var _ Optional[int] = &LockedOption[int]{}
+
+func TestLockedOption(t *testing.T) {
+	var option LockedOption[int]
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			option.Mutate(func(o *Option[int]) {
+				n, _ := o.Value()
+				o.set(n + 1)
+			})
+			option.With(func(int) {})
+		}()
+	}
+	wg.Wait()
+
+	if v := option.Unwrap(); v != 50 {
+		t.Error("Unexpected value:", v)
+	}
+	if old := option.Swap(1); old != 50 {
+		t.Error("Unexpected old value:", old)
+	}
+	if p, err := option.Take(); err != nil || *p != 1 {
+		t.Error("Unexpected Take result:", err)
+	}
+
+	run := false
+	option.WithNone(func() {
+		run = true
+	})
+	if !run {
+		t.Error("Closure not run when it should")
+	}
+
+	if locked := NewLocked(2); locked.Unwrap() != 2 {
+		t.Error("Unexpected value:", locked.Unwrap())
+	}
+}
+
+func TestLockedOption_CompareAndSwap(t *testing.T) {
+	option := NewLocked("a")
+	if option.CompareAndSwap("b", "c") {
+		t.Error("Swapped with a different old value")
+	}
+	if !option.CompareAndSwap("a", "b") || option.Unwrap() != "b" {
+		t.Error("Not swapped with the same old value")
+	}
+
+	maps := NewLocked(map[string]int{"a": 1})
+	eq := func(a, b map[string]int) bool { return a["a"] == b["a"] }
+	if !maps.CompareAndSwapFunc(map[string]int{"a": 1}, nil, eq) || !maps.IsNone() {
+		t.Error("Not swapped with an equal old value")
+	}
+}
+
+func TestLockedOption_WaitSome(t *testing.T) {
+	var option LockedOption[int]
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		option.Swap(1)
+	}()
+
+	v, err := option.WaitSome(context.Background())
+	if err != nil || v != 1 {
+		t.Error("Unexpected result:", v, err)
+	}
+
+	_, _ = option.Take()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err = option.WaitSome(ctx); err != context.DeadlineExceeded {
+		t.Error("Unexpected error:", err)
+	}
+}
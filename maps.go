@@ -0,0 +1,30 @@
+package opzione
+
+import "iter"
+
+// MapGet looks up k in m, returning None if m contains no such key, or if
+// the value is nil.
+func MapGet[K comparable, V any](m map[K]V, k K) *Option[V] {
+	v, ok := m[k]
+	return Of(v, ok)
+}
+
+// SeqGet returns the value paired with the first occurrence of key in seq,
+// or None if key does not occur.
+func SeqGet[K comparable, V any](seq iter.Seq2[K, V], key K) *Option[V] {
+	for k, v := range seq {
+		if k == key {
+			return FromPointer(&v)
+		}
+	}
+	return None[V]()
+}
+
+// FirstPair returns the first pair yielded by seq, or None if seq yields
+// nothing.
+func FirstPair[K, V any](seq iter.Seq2[K, V]) *Option[Pair[K, V]] {
+	for k, v := range seq {
+		return Some(Pair[K, V]{k, v})
+	}
+	return None[Pair[K, V]]()
+}
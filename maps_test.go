@@ -0,0 +1,40 @@
+package opzione
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestMapGet(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if option := MapGet(m, "a"); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := MapGet(m, "b"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := MapGet[string, int](nil, "a"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestSeqGet(t *testing.T) {
+	seq := maps.All(map[string]int{"a": 1})
+	if option := SeqGet(seq, "a"); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := SeqGet(seq, "b"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestFirstPair(t *testing.T) {
+	pair := FirstPair(slices.All([]string{"a", "b"}))
+	if p := pair.Unwrap(); p.First != 0 || p.Second != "a" {
+		t.Error("Unexpected pair:", p)
+	}
+	if pair = FirstPair(slices.All([]string{})); !pair.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,62 @@
+package opzione
+
+import (
+	"fmt"
+)
+
+// Matcher matches optional arguments of mocks. It implements the Matcher
+// interface of gomock, and its Matches method can be given to MatchedBy of
+// testify's mock package:
+//
+//	store.EXPECT().Save(opzione.MatchSome(func(v int) bool { return v > 0 }))
+//	m.On("Save", mock.MatchedBy(opzione.MatchNone[int]().Matches))
+type Matcher[T any] struct {
+	some bool
+	pred func(T) bool
+	desc string
+}
+
+// MatchSome returns a Matcher matching optionals containing a meaningful
+// value for which pred returns true. A nil pred accepts any value.
+func MatchSome[T any](pred func(T) bool) *Matcher[T] {
+	desc := "is Some"
+	if pred != nil {
+		desc = "is Some satisfying predicate"
+	}
+	return &Matcher[T]{some: true, pred: pred, desc: desc}
+}
+
+// MatchNone returns a Matcher matching optionals which are nil or contain
+// no meaningful value.
+func MatchNone[T any]() *Matcher[T] {
+	return &Matcher[T]{desc: "is None"}
+}
+
+// Matches reports whether x matches. x may be an Option[T] or any
+// Optional[T], such as *Option[T].
+func (m *Matcher[T]) Matches(x any) bool {
+	var o Optional[T]
+	switch v := x.(type) {
+	case nil:
+	case Option[T]:
+		o = &v
+	case *Option[T]:
+		if v != nil {
+			o = v
+		}
+	case Optional[T]:
+		o = v
+	default:
+		return false
+	}
+
+	if o == nil || o.IsNone() {
+		return !m.some
+	}
+	return m.some && (m.pred == nil || m.pred(o.Unwrap()))
+}
+
+// String describes what the Matcher matches.
+func (m *Matcher[T]) String() string {
+	return fmt.Sprintf("%s (%v)", m.desc, typeName[T]())
+}
@@ -0,0 +1,34 @@
+package opzione
+
+import "testing"
+
+func TestMatcher(t *testing.T) {
+	positive := MatchSome(func(v int) bool { return v > 0 })
+	for _, x := range []any{Some(1), S(2), NewAtomic(3)} {
+		if !positive.Matches(x) {
+			t.Errorf("Unexpected mismatch: %#v", x)
+		}
+	}
+	for _, x := range []any{Some(-1), None[int](), (*Option[int])(nil), nil, 1, Some("a")} {
+		if positive.Matches(x) {
+			t.Errorf("Unexpected match: %#v", x)
+		}
+	}
+
+	none := MatchNone[int]()
+	for _, x := range []any{None[int](), N[int](), (*Option[int])(nil), nil} {
+		if !none.Matches(x) {
+			t.Errorf("Unexpected mismatch: %#v", x)
+		}
+	}
+	if none.Matches(Some(1)) || !MatchSome[int](nil).Matches(Some(0)) {
+		t.Error("Unexpected result")
+	}
+
+	if s := positive.String(); s != "is Some satisfying predicate (int)" {
+		t.Error("Unexpected description:", s)
+	}
+	if s := none.String(); s != "is None (int)" {
+		t.Error("Unexpected description:", s)
+	}
+}
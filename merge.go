@@ -0,0 +1,55 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Merge copies the meaningful values of the Option fields of patch into the
+// corresponding fields of dst, leaving the other fields of dst untouched. It
+// is the usual way of applying a partial update, such as the body of a PATCH
+// request:
+//
+//	type UserPatch struct {
+//		Name  opzione.Option[string]
+//		Email *opzione.Option[string]
+//	}
+//
+//	err := opzione.Merge(&current, patch)
+//
+// Fields of type Option or *Option are considered, including those of nested
+// struct fields, which are not pointers. A nil *Option field in dst is
+// allocated if there is a value to copy. Unexported fields are ignored. T must
+// be a struct type.
+func Merge[T any](dst *T, patch T) error {
+	if dst == nil {
+		return fmt.Errorf("opzione: cannot merge into nil %T", dst)
+	}
+	d, p := reflect.ValueOf(dst).Elem(), reflect.ValueOf(patch)
+	if d.Kind() != reflect.Struct {
+		return fmt.Errorf("opzione: cannot merge %v, which is not a struct", d.Type())
+	}
+
+	for _, f := range optionFields(d.Type()) {
+		v, ok := optionValue(p.FieldByIndex(f.Index).Interface())
+		if !ok {
+			continue
+		}
+		setOptionField(d.FieldByIndex(f.Index), v)
+	}
+	return nil
+}
+
+// setOptionField stores v in field, which must be a settable Option or
+// *Option, allocating the latter if it is nil.
+func setOptionField(field reflect.Value, v any) {
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field = field.Elem()
+	}
+	field.Addr().Interface().(anySetter).setAny(v)
+}
@@ -0,0 +1,60 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	type address struct {
+		City Option[string]
+	}
+	type user struct {
+		Name    Option[string]
+		Age     *Option[int]
+		Email   *Option[string]
+		Address address
+		Tags    []string
+		private Option[string]
+	}
+
+	dst := user{
+		Name:    S("a"),
+		Email:   Some("a@example.com"),
+		Address: address{City: S("Rome")},
+		Tags:    []string{"x"},
+		private: S("p"),
+	}
+	patch := user{
+		Name:    N[string](),
+		Age:     Some(30),
+		Email:   None[string](),
+		Address: address{City: S("Milan")},
+		Tags:    []string{"y"},
+		private: S("q"),
+	}
+
+	if err := Merge(&dst, patch); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Name.Unwrap() != "a" || dst.Age.Unwrap() != 30 || dst.Email.Unwrap() != "a@example.com" {
+		t.Error("Unexpected result:", dst.Name.Unwrap(), dst.Age.Unwrap(), dst.Email.Unwrap())
+	}
+	if dst.Address.City.Unwrap() != "Milan" {
+		t.Error("Unexpected city:", dst.Address.City.Unwrap())
+	}
+	if dst.Tags[0] != "x" || dst.private.Unwrap() != "p" {
+		t.Error("Unexpected change of other fields")
+	}
+
+	patch.Age.Swap(31)
+	if dst.Age.Unwrap() != 30 {
+		t.Error("Unexpected sharing with patch")
+	}
+
+	if err := Merge(new(int), 1); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if err := Merge[user](nil, patch); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/oissevalt/opzione/msgpackopt
+
+go 1.23
+
+require (
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
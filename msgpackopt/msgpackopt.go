@@ -0,0 +1,60 @@
+// Package msgpackopt provides optional values which are marshalled as
+// MessagePack by github.com/vmihailenco/msgpack/v5. It is a separate module,
+// so that opzione does not depend on the library.
+//
+//	type Message struct {
+//		ID    int64                     `msgpack:"id"`
+//		Reply msgpackopt.Option[string] `msgpack:"reply"`
+//	}
+package msgpackopt
+
+import (
+	"bytes"
+
+	"github.com/oissevalt/opzione"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+var msgpackNil = []byte{0xc0}
+
+// Option is an opzione.Option which implements msgpack.Marshaler and
+// msgpack.Unmarshaler. The contained value is marshalled as it is, and an
+// Option containing no meaningful value is marshalled as nil.
+type Option[T any] struct {
+	opzione.Option[T]
+}
+
+// Some returns an Option containing v, panicking as opzione.Some does if v
+// is nil.
+func Some[T any](v T) Option[T] {
+	return Option[T]{opzione.S(v)}
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{opzione.N[T]()}
+}
+
+// MarshalMsgpack implements msgpack.Marshaler.
+func (o Option[T]) MarshalMsgpack() ([]byte, error) {
+	v, ok := o.Get()
+	if !ok {
+		return msgpackNil, nil
+	}
+	return msgpack.Marshal(v)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler. Nil results in None.
+func (o *Option[T]) UnmarshalMsgpack(data []byte) error {
+	if bytes.Equal(data, msgpackNil) {
+		_, _ = o.Take()
+		return nil
+	}
+
+	var v T
+	if err := msgpack.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Swap(v)
+	return nil
+}
@@ -0,0 +1,41 @@
+package msgpackopt
+
+import (
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Interface assertions
+var (
+	_ msgpack.Marshaler   = Option[int]{}
+	_ msgpack.Unmarshaler = &Option[int]{}
+)
+
+func TestOption_Msgpack(t *testing.T) {
+	type message struct {
+		ID    Option[int64]  `msgpack:"id"`
+		Reply Option[string] `msgpack:"reply"`
+	}
+
+	data, err := msgpack.Marshal(message{ID: Some[int64](1), Reply: None[string]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]any
+	if err = msgpack.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := raw["reply"]; !ok || v != nil {
+		t.Error("None not marshalled as nil:", raw)
+	}
+
+	m := message{Reply: Some("b")}
+	if err = msgpack.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.ID.Unwrap() != 1 || !m.Reply.IsNone() {
+		t.Error("Unexpected result:", m)
+	}
+}
@@ -0,0 +1,73 @@
+package opzione
+
+// Nullable is an optional value with three states: unset, null, and set with
+// a value, as required by the update semantics of REST and GraphQL APIs,
+// where an absent member leaves a field untouched, and null clears it.
+//
+// The zero value of Nullable is unset. When decoded by encoding/json, an
+// absent member leaves the Nullable unset, null makes it null, and other
+// values set it. When encoded, both unset and null are encoded as null, and
+// unset fields tagged with omitzero are omitted.
+type Nullable[T any] struct {
+	v     T
+	state nullableState
+}
+
+type nullableState uint8
+
+const (
+	nullableUnset nullableState = iota
+	nullableNull
+	nullableSet
+)
+
+// NullableSome constructs a Nullable set with v.
+func NullableSome[T any](v T) Nullable[T] {
+	return Nullable[T]{v: v, state: nullableSet}
+}
+
+// NullableNull constructs a null Nullable.
+func NullableNull[T any]() Nullable[T] {
+	return Nullable[T]{state: nullableNull}
+}
+
+// NullableFrom constructs a Nullable from o, which is null if o is nil or
+// None, and set with the contained value otherwise.
+func NullableFrom[T any](o Optional[T]) Nullable[T] {
+	if o == nil || o.IsNone() {
+		return NullableNull[T]()
+	}
+	return NullableSome(o.Unwrap())
+}
+
+// IsUnset reports whether the Nullable is unset.
+func (n Nullable[T]) IsUnset() bool {
+	return n.state == nullableUnset
+}
+
+// IsNull reports whether the Nullable is null.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// IsSet reports whether the Nullable is set with a value.
+func (n Nullable[T]) IsSet() bool {
+	return n.state == nullableSet
+}
+
+// Get returns the value of the Nullable, and whether it is set.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.v, n.state == nullableSet
+}
+
+// Option converts the Nullable into an Option, which is None unless the
+// Nullable is set with a meaningful value.
+func (n Nullable[T]) Option() *Option[T] {
+	return Of(n.v, n.state == nullableSet)
+}
+
+// IsZero reports whether the Nullable is unset, so that unset fields tagged
+// with omitzero are omitted by encoding/json.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableUnset
+}
@@ -0,0 +1,23 @@
+package opzione
+
+import "testing"
+
+func TestNullable(t *testing.T) {
+	var unset Nullable[int]
+	if !unset.IsUnset() || unset.IsNull() || unset.IsSet() || !unset.Option().IsNone() {
+		t.Error("Unexpected state")
+	}
+	if null := NullableNull[int](); !null.IsNull() || !null.Option().IsNone() {
+		t.Error("Unexpected state")
+	}
+	if v, ok := NullableSome(1).Get(); !ok || v != 1 {
+		t.Error("Unexpected value:", v)
+	}
+
+	if !NullableFrom[int](nil).IsNull() || !NullableFrom[int](None[int]()).IsNull() {
+		t.Error("Unexpected state")
+	}
+	if n := NullableFrom[int](Some(2)); n.Option().Unwrap() != 2 {
+		t.Error("Unexpected value:", n.Option().Unwrap())
+	}
+}
@@ -1,7 +1,11 @@
 package opzione
 
 import (
-	"reflect"
+	"fmt"
+	"iter"
+	"sync/atomic"
+	"time"
+	"unsafe"
 )
 
 // Option is an optional type which not only checks if the stored value
@@ -19,52 +23,198 @@ import (
 //
 // For value types and single pointers, Option skips recursive nil checks.
 // Option does not track unsafe pointers, either, as they can be manipulated
-// and interpreted arbitrarily.
+// and interpreted arbitrarily. With the opzione_noreflect build tag, Option
+// tracks nothing and only checks whether the contained value itself is nil.
 type Option[T any] struct {
-	v       *T
-	ptrtyp  bool
-	track   bool
-	validfn func(T) bool
+	v      *T
+	ptrtyp bool
+	track  bool
+	direct bool
+
+	// ext points to the optionMeta[T] of the Option, if any.
+	ext unsafe.Pointer
+}
+
+// optionMeta holds the settings and state which most Options never use, so
+// that they do not enlarge every Option. Copies of an Option share it.
+type optionMeta[T any] struct {
+	depth   int
+	untyped bool
+	// nilslice is set by FromSlice; T is then a slice type.
+	nilslice bool
+	// released and trace are used in debug builds.
+	released bool
+	trace    *tracer
+	validfns []func(T) bool
+	validall bool
+	cause    error
+	retry    time.Time
+
+	// subs points to the subscribers[T] of the Option, if any.
+	subs unsafe.Pointer
+}
+
+// meta returns the metadata of the Option, allocating it on first use. It is
+// safe to call concurrently.
+func (o *Option[T]) meta() *optionMeta[T] {
+	for {
+		if p := atomic.LoadPointer(&o.ext); p != nil {
+			return (*optionMeta[T])(p)
+		}
+		atomic.CompareAndSwapPointer(&o.ext, nil, unsafe.Pointer(new(optionMeta[T])))
+	}
+}
+
+// loadMeta returns the metadata of the Option, or nil if it has none, in
+// which case every setting has its default.
+func (o *Option[T]) loadMeta() *optionMeta[T] {
+	return (*optionMeta[T])(atomic.LoadPointer(&o.ext))
+}
+
+// SetTrackDepth limits how many levels of nested references IsNone follows
+// to n, such that only the topmost n references of an Option[***T] are
+// checked, for instance. If n is not positive, which is the default, all
+// references are followed.
+func (o *Option[T]) SetTrackDepth(n int) {
+	o.meta().depth = n
+}
+
+// DetectTypedNil sets whether IsNone looks into non-nil interfaces in the
+// value, reporting None if they wrap a nil pointer, which is known as a typed
+// nil. It is enabled by default. The setting applies to interface-typed T as
+// well, such as Option[error], which is then None only if the interface
+// itself is nil.
+func (o *Option[T]) DetectTypedNil(enabled bool) {
+	o.meta().untyped = !enabled
 }
 
 // Validate adds custom validation logic when deciding whether the Option's
 // inner value is meaningful or not. The value will be considered "none" if
 // f returns true. The validation function is executed only after all nil
-// checks are done.
+// checks are done. Validate replaces any validators previously registered;
+// use AddValidator to register more than one.
 func (o *Option[T]) Validate(f func(T) bool) {
-	o.validfn = f
+	o.ClearValidators()
+	if f != nil {
+		o.AddValidator(f)
+	}
+}
+
+// ValidatorMode determines how multiple validators of an Option are combined.
+type ValidatorMode int
+
+const (
+	// ValidateOr considers the value "none" if any validator returns true.
+	// This is the default.
+	ValidateOr ValidatorMode = iota
+
+	// ValidateAnd considers the value "none" only if all validators return
+	// true.
+	ValidateAnd
+)
+
+// AddValidator registers f in addition to the existing validators, which are
+// combined according to the mode set by SetValidatorMode, and executed in
+// the order of registration.
+func (o *Option[T]) AddValidator(f func(T) bool) {
+	m := o.meta()
+	m.validfns = append(m.validfns, f)
+}
+
+// ClearValidators removes all validators of the Option.
+func (o *Option[T]) ClearValidators() {
+	if m := o.loadMeta(); m != nil {
+		m.validfns = nil
+	}
+}
+
+// SetValidatorMode sets how the validators of the Option are combined.
+func (o *Option[T]) SetValidatorMode(mode ValidatorMode) {
+	o.meta().validall = mode == ValidateAnd
 }
 
 // IsNone reports whether the Option contains no value, or contains merely
 // a nil pointer or nested pointers to a nil reference.
 func (o *Option[T]) IsNone() bool {
+	o.checkReleased()
+	none := o.none()
+	if debug {
+		if m := o.loadMeta(); m != nil && m.trace != nil {
+			m.trace.observe(none)
+		}
+	}
+	return none
+}
+
+// IsSome reports whether the Option contains a meaningful value, which is
+// the negation of IsNone.
+func (o *Option[T]) IsSome() bool {
+	return !o.IsNone()
+}
+
+func (o *Option[T]) none() bool {
 	if o.v == nil {
 		return true
 	}
 
-	ok := false
-	if o.ptrtyp {
-		val := reflect.ValueOf(*o.v)
-		if o.track {
-			ok = isnil(val)
-		}
-		ok = val.IsNil()
+	if o.isnull() {
+		return true
+	}
+
+	m := o.loadMeta()
+	if m == nil {
+		return false
 	}
-	if ok {
+	if m.nilslice && *(*unsafe.Pointer)(unsafe.Pointer(o.v)) == nil {
+		// A nil slice has a nil array pointer, while an empty one does not.
 		return true
 	}
+	return m.rejected(*o.v)
+}
 
-	if o.validfn != nil {
-		return o.validfn(*o.v)
+// rejected reports whether the validators consider v "none".
+func (m *optionMeta[T]) rejected(v T) bool {
+	if len(m.validfns) == 0 {
+		return false
+	}
+	for _, f := range m.validfns {
+		if f(v) != m.validall {
+			// A single rejection decides ValidateOr, and a single
+			// acceptance decides ValidateAnd.
+			return !m.validall
+		}
 	}
-	return false
+	return m.validall
 }
 
 // Value attempts to retrieve the contained value. If the Option contains no value,
-// is a nil pointer or nested pointers to nil, it will return ErrNoneOptional.
+// is a nil pointer or nested pointers to nil, it will return ErrNoneOptional. If
+// the Option was constructed by Try with an error, the error is wrapped along.
 func (o *Option[T]) Value() (t T, err error) {
 	if o.IsNone() {
-		return t, ErrNoneOptional
+		return t, o.noneErr()
+	}
+	return *o.v, nil
+}
+
+// Get returns the contained value and true, or the zero value and false if
+// the Option contains no meaningful value.
+//
+//	if v, ok := o.Get(); ok {
+//		use(v)
+//	}
+func (o *Option[T]) Get() (t T, ok bool) {
+	if o.IsNone() {
+		return t, false
+	}
+	return *o.v, true
+}
+
+// OkOr is like Value, but returns err instead of ErrNoneOptional if the
+// Option contains no meaningful value, turning None into a domain error.
+func (o *Option[T]) OkOr(err error) (t T, _ error) {
+	if o.IsNone() {
+		return t, err
 	}
 	return *o.v, nil
 }
@@ -73,19 +223,23 @@ func (o *Option[T]) Value() (t T, err error) {
 // meaningful value.
 func (o *Option[T]) Unwrap() T {
 	if o.IsNone() {
-		panic(ErrNoneOptional)
+		Raise[T](o.noneErr())
 	}
 	return *o.v
 }
 
-// Swap swaps the contained value with v, returning the original value. If v is
-// a nil pointer or dereferences to nil, the Option will be put in a "none" state
-// such that subsequent calls to IsNone will return true. Whether the returned
-// value is valid is not guaranteed; if the optional previously contains no
-// meaningful value, it can be the zero value of the type, or nil.
+// Swap swaps the contained value with v, returning the original value. If v
+// is a nil pointer, a typed nil or dereferences to nil, as decided by the
+// settings of the Option, subsequent calls to IsNone will return true, but v
+// is kept and returned as is by the next Swap. Swap is safe to call on an
+// Option with no value, including the zero value, in which case it returns
+// the zero value of T; see Optional for the full guarantee.
 func (o *Option[T]) Swap(v T) (t T) {
-	t = *o.v
-	o.v = &v
+	o.checkReleased()
+	if o.v != nil {
+		t = *o.v
+	}
+	o.set(v)
 	return
 }
 
@@ -99,7 +253,7 @@ func (o *Option[T]) Take() (*T, error) {
 		return nil, ErrNoneOptional
 	}
 	p := o.v
-	o.v = nil
+	o.unset()
 	return p, nil
 }
 
@@ -128,52 +282,106 @@ func (o *Option[T]) Assign(p **T) bool {
 	return true
 }
 
-func isptr[T any](t T) (reflect.Value, bool) {
-	val := reflect.ValueOf(t)
-	if !val.IsValid() {
-		panic("cannot determine t; invalid value detected")
+// Iter returns an iterator yielding the contained value if it is meaningful
+// at the time of iteration, or nothing otherwise.
+//
+//	for v := range opt.Iter() {
+//		...
+//	}
+func (o *Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !o.IsNone() {
+			yield(*o.v)
+		}
 	}
-	return val, isptrkind(val.Kind())
 }
 
-func isptrkind(kind reflect.Kind) bool {
-	return kind == reflect.UnsafePointer ||
-		kind == reflect.Pointer ||
-		kind == reflect.Func ||
-		kind == reflect.Map ||
-		kind == reflect.Chan ||
-		kind == reflect.Interface
+// Ptr returns a pointer to the contained value, or nil if the Option contains
+// no meaningful value. It is useful for APIs that represent optional fields
+// as pointers.
+func (o *Option[T]) Ptr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	return o.v
 }
 
-func isnil(val reflect.Value) bool {
-	if !val.IsValid() {
-		// val is constructed from empty Value{}, nil, or is corrupted.
-		return true
+// RetryAfter returns the deadline until which the Option is known to be
+// absent, if it was constructed by NoneUntil and has not been given a value
+// since.
+func (o *Option[T]) RetryAfter() (time.Time, bool) {
+	m := o.loadMeta()
+	if m == nil || m.retry.IsZero() || !o.IsNone() {
+		return time.Time{}, false
+	}
+	return m.retry, true
+}
+
+// anyValue returns the contained value as any, and whether it is meaningful.
+// It has a value receiver so that both Option and *Option satisfy anyOption.
+func (o Option[T]) anyValue() (any, bool) {
+	if o.IsNone() {
+		return nil, false
+	}
+	return *o.v, true
+}
+
+// set stores v as the contained value, determining the mode from T, since
+// the Option may be a zero value whose mode is undetermined. v is kept even
+// if it is nil or dereferences to nil, in which case the Option is none as
+// decided by its own settings, such as the depth set by SetTrackDepth. Every
+// method modifying the Option goes through set or unset, which notify
+// watchers and hooks of the modification.
+func (o *Option[T]) set(v T) {
+	wasNone := o.hasHooks() && o.none()
+	o.v = &v
+	o.setplan(planFor[T]())
+	if m := o.loadMeta(); m != nil {
+		m.cause = nil
+		m.retry = time.Time{}
 	}
+	o.traced()
+	o.notify(wasNone)
+}
+
+// unset removes the contained value, as when decoding null, notifying
+// watchers and hooks like set.
+func (o *Option[T]) unset() {
+	wasNone := o.hasHooks() && o.none()
+	o.v = nil
+	o.traced()
+	o.notify(wasNone)
+}
 
-	switch val.Kind() {
-	case reflect.UnsafePointer:
-		// An unsafe pointer can be anything; the package is only responsible
-		// for checking the shallowest reference.
-		return val.UnsafePointer() == nil
-	case reflect.Pointer:
-		elem := val.Elem()
-		if !elem.IsValid() {
-			// The pointer dereferences to nil; p := &i where i is nil.
-			return true
+// anyOption is implemented by Option and *Option of every type, for code
+// which cannot know the type parameter statically.
+type anyOption interface {
+	anyValue() (any, bool)
+}
+
+// optionValue returns the value contained in x if x is an Option, or x
+// itself otherwise, reporting whether the value is meaningful.
+func optionValue(x any) (any, bool) {
+	if o, ok := x.(anyOption); ok {
+		if isnilany(x) {
+			// x is a nil *Option.
+			return nil, false
 		}
-		// Continue this process with the pointed object.
-		return isnil(elem)
-	case reflect.Func, reflect.Map, reflect.Chan, reflect.Interface:
-		// These are pointer-like types. They can be nil and calling a nil
-		// value may trigger a runtime panic.
-		return val.IsNil()
-	case reflect.Slice:
-		// A nil slice is safe to use. In the context of this package, we
-		// don't consider it purely "nil" as opposed to a pointer.
-		return false
-	default:
-		// Value types; cannot be nil.
-		return false
+		return o.anyValue()
+	}
+	return x, !isnilany(x)
+}
+
+func (o *Option[T]) noneErr() error {
+	if m := o.loadMeta(); m != nil && m.cause != nil {
+		return fmt.Errorf("%w: %w", ErrNoneOptional, m.cause)
+	}
+	return ErrNoneOptional
+}
+
+func nilif(null bool) int {
+	if null {
+		return 0
 	}
+	return -1
 }
@@ -0,0 +1,59 @@
+package opzione
+
+import "sync"
+
+// OptionMap is a map safe for concurrent use, built on sync.Map, whose
+// lookups return options. The zero value of OptionMap is empty and ready
+// to use.
+type OptionMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Get returns the value stored for k, or None if there is no such value.
+func (m *OptionMap[K, V]) Get(k K) *Option[V] {
+	v, ok := m.m.Load(k)
+	if !ok {
+		return None[V]()
+	}
+	return Of(mapValue[V](v), true)
+}
+
+// Store stores v for k.
+func (m *OptionMap[K, V]) Store(k K, v V) {
+	m.m.Store(k, v)
+}
+
+// GetOrCompute returns the value stored for k if present. Otherwise, it
+// stores and returns the result of f. If multiple goroutines compute the
+// value for the same key simultaneously, f may be called more than once,
+// but only one of the results is stored and returned to all of them.
+func (m *OptionMap[K, V]) GetOrCompute(k K, f func() V) V {
+	if v, ok := m.m.Load(k); ok {
+		return mapValue[V](v)
+	}
+	v, _ := m.m.LoadOrStore(k, f())
+	return mapValue[V](v)
+}
+
+// Delete deletes the value stored for k, returning it as an Option.
+func (m *OptionMap[K, V]) Delete(k K) *Option[V] {
+	v, ok := m.m.LoadAndDelete(k)
+	if !ok {
+		return None[V]()
+	}
+	return Of(mapValue[V](v), true)
+}
+
+// Range calls f for each key and value in the map, until f returns false.
+// It has the same consistency guarantees as sync.Map.Range.
+func (m *OptionMap[K, V]) Range(f func(k K, v V) bool) {
+	m.m.Range(func(k, v any) bool {
+		return f(k.(K), mapValue[V](v))
+	})
+}
+
+// mapValue converts v to V, which fails only if v is a nil interface value.
+func mapValue[V any](v any) V {
+	t, _ := v.(V)
+	return t
+}
@@ -0,0 +1,52 @@
+package opzione
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestOptionMap(t *testing.T) {
+	var m OptionMap[string, int]
+	if option := m.Get("a"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.GetOrCompute("a", func() int { return 1 })
+		}()
+	}
+	wg.Wait()
+
+	m.Store("b", 2)
+	if option := m.Get("a"); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	sum := 0
+	m.Range(func(_ string, v int) bool {
+		sum += v
+		return true
+	})
+	if sum != 3 {
+		t.Error("Unexpected sum:", sum)
+	}
+
+	if option := m.Delete("b"); option.Unwrap() != 2 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := m.Delete("b"); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestOptionMap_NilInterface(t *testing.T) {
+	var m OptionMap[int, error]
+	m.Store(1, nil)
+	if option := m.Get(1); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
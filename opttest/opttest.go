@@ -0,0 +1,128 @@
+// Package opttest provides assertions on optional values for tests.
+//
+//	func TestLookup(t *testing.T) {
+//		opttest.RequireSomeEqual(t, cache.Lookup("a"), 1)
+//		opttest.AssertNone(t, cache.Lookup("b"))
+//	}
+//
+// Assert functions report failures with t.Error and return whether they
+// succeeded, while Require functions stop the test with t.Fatal. A nil
+// Optional is considered None.
+package opttest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/oissevalt/opzione"
+)
+
+// AssertSome reports an error if o is None.
+func AssertSome[T any](t testing.TB, o opzione.Optional[T]) bool {
+	t.Helper()
+	if isNone(o) {
+		t.Errorf("expected Some %v, got None", typeName[T]())
+		return false
+	}
+	return true
+}
+
+// AssertNone reports an error if o is not None.
+func AssertNone[T any](t testing.TB, o opzione.Optional[T]) bool {
+	t.Helper()
+	if !isNone(o) {
+		t.Errorf("expected None, got Some(%#v)", o.Unwrap())
+		return false
+	}
+	return true
+}
+
+// AssertSomeEqual reports an error if o is None, or its value is not equal
+// to want, as reported by reflect.DeepEqual, along with the difference.
+func AssertSomeEqual[T any](t testing.TB, o opzione.Optional[T], want T) bool {
+	t.Helper()
+	if msg, ok := someEqual(o, want); !ok {
+		t.Error(msg)
+		return false
+	}
+	return true
+}
+
+// RequireSome is like AssertSome, but stops the test on failure. It returns
+// the contained value.
+func RequireSome[T any](t testing.TB, o opzione.Optional[T]) T {
+	t.Helper()
+	if !AssertSome(t, o) {
+		t.FailNow()
+	}
+	return o.Unwrap()
+}
+
+// RequireNone is like AssertNone, but stops the test on failure.
+func RequireNone[T any](t testing.TB, o opzione.Optional[T]) {
+	t.Helper()
+	if !AssertNone(t, o) {
+		t.FailNow()
+	}
+}
+
+// RequireSomeEqual is like AssertSomeEqual, but stops the test on failure.
+func RequireSomeEqual[T any](t testing.TB, o opzione.Optional[T], want T) {
+	t.Helper()
+	if !AssertSomeEqual(t, o, want) {
+		t.FailNow()
+	}
+}
+
+func isNone[T any](o opzione.Optional[T]) bool {
+	return o == nil || o.IsNone()
+}
+
+func someEqual[T any](o opzione.Optional[T], want T) (string, bool) {
+	if isNone(o) {
+		return fmt.Sprintf("expected Some(%#v), got None", want), false
+	}
+	got := o.Unwrap()
+	if reflect.DeepEqual(got, want) {
+		return "", true
+	}
+	return "unexpected value (-want +got):\n" + diff(want, got), false
+}
+
+// diff returns the lines of the formatted want and got which differ.
+func diff(want, got any) string {
+	w := strings.Split(fmt.Sprintf("%+v", want), "\n")
+	g := strings.Split(fmt.Sprintf("%+v", got), "\n")
+	if len(w) == 1 && len(g) == 1 {
+		w, g = fields(fmt.Sprintf("%#v", want)), fields(fmt.Sprintf("%#v", got))
+	}
+
+	var b strings.Builder
+	for i := range max(len(w), len(g)) {
+		switch {
+		case i >= len(w):
+			fmt.Fprintf(&b, "\t+ %s\n", g[i])
+		case i >= len(g):
+			fmt.Fprintf(&b, "\t- %s\n", w[i])
+		case w[i] != g[i]:
+			fmt.Fprintf(&b, "\t- %s\n\t+ %s\n", w[i], g[i])
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// fields splits the Go-syntax representation of a struct into lines of its
+// fields, so that only differing fields are shown.
+func fields(s string) []string {
+	open := strings.IndexByte(s, '{')
+	if open < 0 || !strings.HasSuffix(s, "}") || strings.Count(s, "{") > 1 {
+		return []string{s}
+	}
+	return strings.Split(s[open+1:len(s)-1], ", ")
+}
+
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
@@ -0,0 +1,70 @@
+package opttest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/oissevalt/opzione"
+)
+
+// recorder records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Error(args ...any) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recorder) FailNow() {
+	r.failed = true
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func TestAssertions(t *testing.T) {
+	r := &recorder{TB: t}
+	if !AssertSome[int](r, opzione.Some(1)) || !AssertNone[int](r, opzione.None[int]()) || !AssertNone[int](r, nil) {
+		t.Error("Unexpected failure:", r.errors)
+	}
+	if !AssertSomeEqual[user](r, opzione.Some(user{"a", 1}), user{"a", 1}) {
+		t.Error("Unexpected failure:", r.errors)
+	}
+	RequireSomeEqual[int](r, opzione.Some(1), 1)
+	if v := RequireSome[int](r, opzione.Some(2)); v != 2 || r.failed {
+		t.Error("Unexpected failure:", r.errors)
+	}
+
+	if AssertSome[int](r, opzione.None[int]()) || AssertNone[int](r, opzione.Some(1)) {
+		t.Error("Unexpected success")
+	}
+	if AssertSomeEqual[user](r, opzione.Some(user{"a", 2}), user{"a", 1}) {
+		t.Error("Unexpected success")
+	}
+	RequireNone[int](r, opzione.Some(1))
+	if !r.failed {
+		t.Error("Unexpected success")
+	}
+
+	want := []string{
+		"expected Some int, got None",
+		"expected None, got Some(1)",
+		"unexpected value (-want +got):\n\t- Age:1\n\t+ Age:2",
+		"expected None, got Some(1)",
+	}
+	if strings.Join(r.errors, "|") != strings.Join(want, "|") {
+		t.Errorf("Unexpected errors: %q", r.errors)
+	}
+}
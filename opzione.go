@@ -3,27 +3,51 @@ package opzione
 
 import (
 	"errors"
-	"reflect"
+	"iter"
+	"slices"
+	"time"
 )
 
 var ErrNoneOptional = errors.New("optional value is none")
 
+// ErrStrict is returned by strict-mode constructors such as SomeStrict when
+// a value is rejected.
+var ErrStrict = errors.New("value rejected in strict mode")
+
+// ErrNilValue is returned by TrySome when the value is nil or dereferences
+// to nil.
+var ErrNilValue = errors.New("nil pointer cannot be used to construct Some")
+
+// ErrReleased is the error of the panic raised by an Option used after
+// Release, when built with the opzione_debug tag.
+var ErrReleased = errors.New("use of released Option")
+
 type Optional[T interface{}] interface {
 	// IsNone reports whether the current optional contains no meaningful value.
 	// A value is meaningful if it is not a nil pointer or nested pointers that
 	// ultimately dereference to nil.
 	IsNone() bool
 
+	// IsSome reports whether the current optional contains a meaningful
+	// value, as the negation of IsNone.
+	IsSome() bool
+
 	// Value tries to obtain the contained value. If the optional contains
 	// no value, it returns ErrNoneOptional.
 	Value() (t T, err error)
 
+	// Get returns the contained value and true, or the zero value and false
+	// if the optional contains no value, for the "comma ok" idiom.
+	Get() (t T, ok bool)
+
 	// Unwrap obtains the contained value, and panics if the optional
 	// contains no value.
 	Unwrap() T
 
-	// Swap swaps value v with the optional's contained value. Swap returns
-	// the original value, with no guarantee that it will be present.
+	// Swap swaps value v with the optional's contained value, returning the
+	// original value. Swap never panics; if the optional contains no value,
+	// it returns the zero value of T. A value which is present but not
+	// meaningful, such as a pointer to a nil pointer, is returned as is.
 	Swap(v T) T
 
 	// Take attempts to move out the optional's contained value.
@@ -41,46 +65,203 @@ type Optional[T interface{}] interface {
 	// Assign assigns the optional's contained value to *p, if the optional
 	// is not None.
 	Assign(p **T) bool
+
+	// Iter returns an iterator yielding the optional's contained value, if
+	// it contains any, or nothing otherwise.
+	Iter() iter.Seq[T]
+}
+
+// AnyOptional is the part of Optional that does not depend on the type of
+// the contained value. Every Optional is an AnyOptional.
+type AnyOptional interface {
+	IsNone() bool
 }
 
 // Some constructs an Option with value. It panics if v is a nil pointer
 // or a nested pointer to nil, with nil slices being an exception.
 func Some[T any](v T) *Option[T] {
-	val, ok := isptr(v)
-	if ok {
-		if isnil(val) {
-			panic("nil pointer cannot be used to construct Some")
-		}
-		switch val.Kind() {
-		case reflect.UnsafePointer:
-			// Only responsible for the topmost reference.
-			return &Option[T]{v: &v, ptrtyp: true, track: false}
-		case reflect.Pointer, reflect.Interface:
-			// If v is a simple pointer, there is no need to resort to
-			// reflection.
-			tr := isptrkind(val.Elem().Kind())
-			return &Option[T]{v: &v, ptrtyp: true, track: tr}
-		default:
-			return &Option[T]{v: &v, ptrtyp: true, track: true}
+	o, err := TrySome(v)
+	if err != nil {
+		Raise[T](err)
+	}
+	return o
+}
+
+// TrySome is like Some, but returns ErrNilValue instead of panicking if v is
+// a nil pointer or a nested pointer to nil, for code wrapping values from
+// untrusted sources.
+func TrySome[T any](v T) (*Option[T], error) {
+	p, null := classify(&v)
+	if null {
+		return nil, ErrNilValue
+	}
+	o := &Option[T]{v: &v}
+	o.setplan(p)
+	o.traced()
+	return o, nil
+}
+
+// SomeDepth is like Some, but the Option follows at most n levels of nested
+// references, as set by SetTrackDepth. It panics only if v is nil within the
+// first n levels.
+func SomeDepth[T any](v T, n int) *Option[T] {
+	p, _ := classify(&v)
+	o := &Option[T]{v: &v}
+	o.setplan(p)
+	o.meta().depth = n
+	o.traced()
+	if o.isnull() {
+		Raise[T](ErrNilValue)
+	}
+	return o
+}
+
+// SomeOrNone constructs an Option with v, which is None if v is a nil pointer
+// or a nested pointer to nil, instead of panicking like Some. It is the same
+// as FromPointer(&v).
+func SomeOrNone[T any](v T) *Option[T] {
+	return FromPointer(&v)
+}
+
+// SomeAll constructs an Option for each value in vs, as if by Some. The
+// Options and a copy of the values share a single backing array each, so
+// that large option-slices do not require an allocation per element.
+func SomeAll[T any](vs []T) []*Option[T] {
+	values := slices.Clone(vs)
+	opts := make([]Option[T], len(vs))
+	ptrs := make([]*Option[T], len(vs))
+	for i := range values {
+		p, null := classify(&values[i])
+		if null {
+			Raise[T](ErrNilValue)
 		}
+		opts[i].v = &values[i]
+		opts[i].setplan(p)
+		opts[i].traced()
+		ptrs[i] = &opts[i]
 	}
-	return &Option[T]{v: &v, ptrtyp: false, track: false}
+	return ptrs
 }
 
-// None constructs an Option with no value.
+// None constructs an Option with no value. Unlike Some, which inspects the
+// given value, None determines how the Option should be tracked from T
+// itself, so it is well-defined for interface types as well.
 func None[T any]() *Option[T] {
-	var t T
-	val, ok := isptr(t)
-	if ok {
-		switch val.Kind() {
-		case reflect.UnsafePointer:
-			return &Option[T]{ptrtyp: true, track: false}
-		case reflect.Pointer, reflect.Interface:
-			tr := isptrkind(val.Elem().Kind())
-			return &Option[T]{ptrtyp: true, track: tr}
-		default:
-			return &Option[T]{ptrtyp: true, track: true}
-		}
+	o := new(Option[T])
+	o.setplan(planFor[T]())
+	o.traced()
+	return o
+}
+
+// NoneUntil constructs an Option with no value which is known to be absent
+// until t, such as a cached negative lookup result. The deadline can be
+// retrieved with RetryAfter.
+func NoneUntil[T any](t time.Time) *Option[T] {
+	o := None[T]()
+	o.meta().retry = t
+	return o
+}
+
+// NoneN constructs n Options with no value, sharing a single backing array.
+func NoneN[T any](n int) []*Option[T] {
+	var mode Option[T]
+	mode.setplan(planFor[T]())
+	opts := make([]Option[T], n)
+	ptrs := make([]*Option[T], n)
+	for i := range opts {
+		opts[i] = mode
+		opts[i].traced()
+		ptrs[i] = &opts[i]
+	}
+	return ptrs
+}
+
+// FromPointer constructs an Option with the value p points to. Unlike Some,
+// it does not panic but returns None if p is nil or dereferences to nil.
+func FromPointer[T any](p *T) *Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	v := *p
+	pl, null := classify(&v)
+	if null {
+		return None[T]()
+	}
+	o := &Option[T]{v: &v}
+	o.setplan(pl)
+	o.traced()
+	return o
+}
+
+// FromSlice constructs an Option with s, which is None if s is nil. Unlike
+// other Options, which consider nil slices valid values, the Option keeps
+// treating nil slices as None, such that "no list" can be distinguished from
+// an empty list.
+//
+//	opt := FromSlice([]int{}) // Some
+//	opt.Swap(nil)             // None
+func FromSlice[S ~[]E, E any](s S) *Option[S] {
+	o := &Option[S]{v: &s}
+	o.meta().nilslice = true
+	o.traced()
+	return o
+}
+
+// Of constructs an Option from the comma-ok idiom, such as results of map
+// lookups, type assertions and channel receives. It returns None if ok is
+// false, or if v is nil or dereferences to nil.
+//
+//	v, ok := m[k]
+//	opt := Of(v, ok)
+func Of[T any](v T, ok bool) *Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return FromPointer(&v)
+}
+
+// Try constructs an Option from a value-error pair, typically returned by
+// a function call. It returns None if err is not nil, in which case err is
+// recorded and wrapped in the error returned by Value.
+//
+//	opt := Try(strconv.Atoi(s))
+func Try[T any](v T, err error) *Option[T] {
+	if err != nil {
+		o := None[T]()
+		o.meta().cause = err
+		return o
+	}
+	return FromPointer(&v)
+}
+
+// S is like Some, but returns the Option by value so that it can be used
+// inline in struct literals and table-driven tests.
+func S[T any](v T) Option[T] {
+	return *Some(v)
+}
+
+// N is like None, but returns the Option by value.
+func N[T any]() Option[T] {
+	return *None[T]()
+}
+
+// OrNoop returns the value contained in o, or noop if o contains no value.
+// It is intended for wiring optional dependencies, such as a tracer or a
+// cache, where None should transparently resolve to a no-op implementation
+// instead of branching at every call site:
+//
+//	type Service struct {
+//		tracer opzione.Optional[Tracer]
+//	}
+//
+//	func (s *Service) Do() {
+//		span := opzione.OrNoop(s.tracer, NoopTracer{}).Start("do")
+//		defer span.End()
+//		...
+//	}
+func OrNoop[T any](o Optional[T], noop T) T {
+	if o == nil || o.IsNone() {
+		return noop
 	}
-	return &Option[T]{track: false}
+	return o.Unwrap()
 }
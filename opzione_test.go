@@ -1,9 +1,14 @@
 package opzione
 
 import (
+	"errors"
 	"os"
+	"slices"
+	"strconv"
+	"syscall"
 	"testing"
 	"time"
+	"unsafe"
 )
 
 // Interface assertions
@@ -72,6 +77,16 @@ func BenchmarkNestedPointer(b *testing.B) {
 	}
 }
 
+func BenchmarkSimplePointer(b *testing.B) {
+	number := 10
+	optional := Some(&number)
+	for i := 0; i < b.N; i++ {
+		if optional.IsNone() {
+			b.Fatal("Unexpected None")
+		}
+	}
+}
+
 func TestOption_Validate(t *testing.T) {
 	file, err := os.Open("go.mod")
 	if err != nil {
@@ -194,6 +209,8 @@ func TestSimplePointers(t *testing.T) {
 }
 
 func TestChainedOptional(t *testing.T) {
+	SkipNoReflect(t)
+
 	number := 10
 	numptr := &number
 	nilptr := (**int)(nil)
@@ -342,6 +359,572 @@ func TestPointerTypes(t *testing.T) {
 	c <- 28
 }
 
+func TestOption_Size(t *testing.T) {
+	// Rarely used settings are kept out of line, behind a single pointer.
+	if size := unsafe.Sizeof(Option[int]{}); size > 3*unsafe.Sizeof(uintptr(0)) {
+		t.Error("Unexpected size:", size)
+	}
+	if debug {
+		return
+	}
+	if allocs := testing.AllocsPerRun(100, func() { _ = Some(1) }); allocs > 2 {
+		t.Error("Unexpected allocations:", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { _ = None[int]() }); allocs > 1 {
+		t.Error("Unexpected allocations:", allocs)
+	}
+}
+
+func TestValueConstructors(t *testing.T) {
+	cases := []struct {
+		option Option[int]
+		none   bool
+	}{
+		{S(1), false},
+		{N[int](), true},
+	}
+	for _, c := range cases {
+		if c.option.IsNone() != c.none {
+			t.Error("Unexpected state:", c.option.IsNone())
+		}
+	}
+}
+
+func TestOption_Ptr(t *testing.T) {
+	option := Some("value")
+	if p := option.Ptr(); p == nil || *p != "value" {
+		t.Error("Unexpected pointer:", p)
+	}
+
+	option = None[string]()
+	if p := option.Ptr(); p != nil {
+		t.Error("Unexpected non-nil pointer:", *p)
+	}
+}
+
+func TestFromPointer(t *testing.T) {
+	number := 10
+	if option := FromPointer(&number); option.Unwrap() != 10 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	if option := FromPointer[int](nil); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	var numptr *int
+	if option := FromPointer(&numptr); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestOf(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	if option := Of(m["a"], true); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	v, ok := m["b"]
+	if option := Of(v, ok); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if option := Of[*int](nil, true); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestTry(t *testing.T) {
+	if option := Try(strconv.Atoi("12")); option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	option := Try(strconv.Atoi("twelve"))
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	_, err := option.Value()
+	if !errors.Is(err, ErrNoneOptional) || !errors.Is(err, strconv.ErrSyntax) {
+		t.Error("Unexpected error:", err)
+	}
+}
+
+func TestOption_OkOr(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	if v, err := Some(1).OkOr(errNotFound); v != 1 || err != nil {
+		t.Error("Unexpected result:", v, err)
+	}
+	if _, err := None[int]().OkOr(errNotFound); err != errNotFound {
+		t.Error("Unexpected error:", err)
+	}
+}
+
+func TestNoneInterface(t *testing.T) {
+	option := None[error]()
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err := option.Value(); err != ErrNoneOptional {
+		t.Error("Unexpected error:", err)
+	}
+
+	if option := None[**int](); !option.track && !noreflect {
+		t.Error("Nested pointer not tracked")
+	}
+}
+
+func TestNoneUntil(t *testing.T) {
+	deadline := time.Now().Add(time.Minute)
+
+	option := NoneUntil[int](deadline)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if retry, ok := option.RetryAfter(); !ok || !retry.Equal(deadline) {
+		t.Error("Unexpected deadline:", retry, ok)
+	}
+
+	if _, ok := None[int]().RetryAfter(); ok {
+		t.Error("Unexpected deadline")
+	}
+}
+
+func TestSomeAll(t *testing.T) {
+	values := []int{1, 2, 3}
+	options := SomeAll(values)
+	for i, option := range options {
+		if option.Unwrap() != values[i] {
+			t.Error("Unexpected value:", option.Unwrap())
+		}
+	}
+
+	ShouldPanic(t, func() {
+		_ = SomeAll([]*int{nil})
+	}, true)
+
+	for _, option := range NoneN[*int](3) {
+		if !option.IsNone() {
+			t.Error("Unexpected Some")
+		}
+	}
+}
+
+func TestOrNoop(t *testing.T) {
+	if v := OrNoop[int](Some(1), 0); v != 1 {
+		t.Error("Unexpected value:", v)
+	}
+	if v := OrNoop[int](None[int](), 0); v != 0 {
+		t.Error("Unexpected value:", v)
+	}
+	if v := OrNoop[int](nil, 0); v != 0 {
+		t.Error("Unexpected value:", v)
+	}
+}
+
+func TestOption_Iter(t *testing.T) {
+	var values []int
+	for v := range Some(1).Iter() {
+		values = append(values, v)
+	}
+	for v := range None[int]().Iter() {
+		values = append(values, v)
+	}
+	for v := range NewAtomic(2).Iter() {
+		values = append(values, v)
+	}
+	for v := range NewLocked(3).Iter() {
+		values = append(values, v)
+	}
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Error("Unexpected values:", values)
+	}
+}
+
+func TestDirectPointers(t *testing.T) {
+	SkipNoReflect(t)
+
+	number := 10
+	option := Some(&number)
+	if !option.direct {
+		t.Fatal("Single pointer not checked directly")
+	}
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.Swap(nil)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if Some(&option).direct || Some[any](&number).direct || Some(number).direct {
+		t.Error("Unexpected direct check")
+	}
+
+	m := Some(map[int]int{})
+	if !m.direct || m.IsNone() {
+		t.Error("Unexpected map check")
+	}
+	m.Swap(nil)
+	if !m.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestIsNonePaths(t *testing.T) {
+	SkipNoReflect(t)
+
+	i := 1
+	p := &i
+	pp := &p
+
+	// Tracked
+	tracked := Some(pp)
+	if tracked.IsNone() {
+		t.Error("Unexpected None")
+	}
+	p = nil
+	if !tracked.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	p = &i
+
+	// Interfaces are tracked by their dynamic value
+	var a any = &i
+	dynamic := Some(a)
+	if !dynamic.ptrtyp || !dynamic.track || dynamic.direct {
+		t.Fatal("Unexpected mode")
+	}
+	if dynamic.IsNone() {
+		t.Error("Unexpected None")
+	}
+	dynamic.Swap(1)
+	if dynamic.IsNone() {
+		t.Error("Unexpected None")
+	}
+	dynamic.Swap(nil)
+	if !dynamic.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	dynamic.Swap((*int)(nil))
+	if !dynamic.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestSwapNone(t *testing.T) {
+	var zero Option[*int]
+	if v := zero.Swap(nil); v != nil || !zero.IsNone() {
+		t.Error("Unexpected value:", v)
+	}
+
+	var nilptr *int
+	var nested Option[**int]
+	if nested.Swap(&nilptr); !nested.IsNone() && !noreflect {
+		t.Error("Unexpected Some")
+	}
+	if a := Some[any](1); a.Swap(nil) != 1 || !a.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if e := Some[error](os.ErrNotExist); e.Swap((*os.PathError)(nil)) != os.ErrNotExist || !e.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	i := 1
+	option := None[*int]()
+	if v := option.Swap(&i); v != nil || *option.Unwrap() != 1 {
+		t.Error("Unexpected value:", v)
+	}
+	if _, err := option.Take(); err != nil {
+		t.Fatal(err)
+	}
+	if v := option.Swap(nil); v != nil || !option.IsNone() {
+		t.Error("Unexpected value:", v)
+	}
+
+	// A present but not meaningful value is kept, and returned as is.
+	if v := nested.Swap(nil); v != &nilptr {
+		t.Error("Unexpected value:", v)
+	}
+
+	// The value is classified with the settings of the Option.
+	p := &i
+	shallow := SomeDepth(&p, 1)
+	if shallow.Swap(&nilptr); shallow.IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	for _, o := range []Optional[int]{None[int](), new(AtomicOption[int]), new(LockedOption[int]), &Val[int]{}} {
+		if v := o.Swap(1); v != 0 || o.Unwrap() != 1 {
+			t.Errorf("Unexpected value from %T: %v", o, v)
+		}
+	}
+}
+
+func TestTrackDepth(t *testing.T) {
+	SkipNoReflect(t)
+
+	i := 1
+	p := &i
+	pp := &p
+	ppp := &pp
+
+	option := SomeDepth(ppp, 2)
+	p = nil
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.SetTrackDepth(3)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	option.SetTrackDepth(0)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	pp = nil
+	option.SetTrackDepth(2)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	ShouldPanic(t, func() { SomeDepth(ppp, 2) }, true)
+	p, pp = &i, nil
+	ShouldPanic(t, func() { SomeDepth(&pp, 3) }, true)
+}
+
+func TestFromSlice(t *testing.T) {
+	if !FromSlice([]int(nil)).IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option := FromSlice([]int{})
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.Swap(nil)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if option.Swap([]int{1}); len(option.Unwrap()) != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	option.Swap(nil)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if Some([]int(nil)).IsNone() {
+		t.Error("Unexpected None")
+	}
+}
+
+func TestTrySome(t *testing.T) {
+	i := 1
+	if o, err := TrySome(&i); err != nil || *o.Unwrap() != 1 {
+		t.Error("Unexpected result:", err)
+	}
+
+	var p *int
+	if o, err := TrySome(p); err != ErrNilValue || o != nil {
+		t.Error("Unexpected result:", o, err)
+	}
+	if o, err := TrySome(&p); !noreflect && (err != ErrNilValue || o != nil) {
+		t.Error("Unexpected result:", o, err)
+	}
+	if _, err := TrySome[any](nil); err != ErrNilValue {
+		t.Error("Unexpected error:", err)
+	}
+}
+
+func TestSomeOrNone(t *testing.T) {
+	i := 1
+	if o := SomeOrNone(&i); o.IsNone() || *o.Unwrap() != 1 {
+		t.Error("Unexpected None")
+	}
+
+	var p *int
+	if !SomeOrNone(p).IsNone() || !SomeOrNone[any](nil).IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if !SomeOrNone(&p).IsNone() && !noreflect {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestTypedNil(t *testing.T) {
+	var p *os.PathError
+	err := error(&os.PathError{})
+	option := Some(&err)
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	if !noreflect {
+		err = p
+		if !option.IsNone() {
+			t.Error("Unexpected Some")
+		}
+		option.DetectTypedNil(false)
+		if option.IsNone() {
+			t.Error("Unexpected None")
+		}
+
+		ShouldPanic(t, func() { Some(&err) }, true)
+		ShouldPanic(t, func() { Some[any](&err) }, true)
+	}
+
+	// The dynamic value of an interface is checked whenever it changes.
+	for _, e := range []error{syscall.ENOENT, &os.PathError{}} {
+		option := Some(e)
+		option.Swap(p)
+		if !option.IsNone() {
+			t.Error("Unexpected Some after Swap:", e)
+		}
+
+		option.Swap(e)
+		var ep *error
+		option.Assign(&ep)
+		*ep = p
+		if !option.IsNone() {
+			t.Error("Unexpected Some after Assign:", e)
+		}
+	}
+
+	// With detection disabled, only a nil interface is None.
+	opaque := Some[error](syscall.ENOENT)
+	opaque.DetectTypedNil(false)
+	if opaque.Swap(p); opaque.IsNone() {
+		t.Error("Unexpected None")
+	}
+	if opaque.Swap(nil); !opaque.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestValidators(t *testing.T) {
+	option := Some(5)
+	option.AddValidator(func(v int) bool { return v < 0 })
+	option.AddValidator(func(v int) bool { return v > 10 })
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.Swap(11)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option.SetValidatorMode(ValidateAnd)
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.AddValidator(func(v int) bool { return v%2 == 1 })
+	option.ClearValidators()
+	option.AddValidator(func(v int) bool { return v > 10 })
+	option.AddValidator(func(v int) bool { return v%2 == 1 })
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	option.Swap(12)
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	option.Validate(func(v int) bool { return v == 12 })
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	option.ClearValidators()
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+}
+
+// optionals returns every implementation of Optional, containing v if some
+// is true, so that they can be checked for interchangeability.
+func optionals(v int, some bool) map[string]Optional[int] {
+	lazy := func() (int, error) {
+		if !some {
+			return 0, ErrNoneOptional
+		}
+		return v, nil
+	}
+	val, atomic := NoneVal[int](), new(AtomicOption[int])
+	opt, locked := None[int](), new(LockedOption[int])
+	if some {
+		val, atomic = SomeVal(v), NewAtomic(v)
+		opt, locked = Some(v), NewLocked(v)
+	}
+	return map[string]Optional[int]{
+		"Option":       opt,
+		"AtomicOption": atomic,
+		"LockedOption": locked,
+		"Lazy":         NewLazy(lazy),
+		"Val":          &val,
+	}
+}
+
+func TestOptionalImplementations(t *testing.T) {
+	for name, o := range optionals(1, true) {
+		var got []int
+		o.With(func(v int) { got = append(got, v) })
+		o.WithNone(func() { t.Error("Unexpected WithNone:", name) })
+		for v := range o.Iter() {
+			got = append(got, v)
+		}
+		var p *int
+		if o.Assign(&p) {
+			got = append(got, *p)
+		}
+		if v, err := o.Value(); err == nil {
+			got = append(got, v)
+		}
+		if v, ok := o.Get(); ok {
+			got = append(got, v)
+		}
+		got = append(got, o.Unwrap())
+		if !slices.Equal(got, []int{1, 1, 1, 1, 1, 1}) || o.IsNone() || !o.IsSome() {
+			t.Error("Unexpected values:", name, got)
+		}
+		if old := o.Swap(2); old != 1 || o.Unwrap() != 2 {
+			t.Error("Unexpected Swap:", name, old)
+		}
+		if p, err := o.Take(); err != nil || *p != 2 || !o.IsNone() {
+			t.Error("Unexpected Take:", name, err)
+		}
+	}
+
+	for name, o := range optionals(1, false) {
+		called := false
+		o.With(func(int) { t.Error("Unexpected With:", name) })
+		o.WithNone(func() { called = true })
+		for range o.Iter() {
+			t.Error("Unexpected Iter:", name)
+		}
+		var p *int
+		if o.Assign(&p) || !called || !o.IsNone() || o.IsSome() {
+			t.Error("Unexpected Some:", name)
+		}
+		if _, err := o.Value(); !errors.Is(err, ErrNoneOptional) {
+			t.Error("Unexpected error:", name, err)
+		}
+		if v, ok := o.Get(); ok || v != 0 {
+			t.Error("Unexpected Get:", name, v)
+		}
+		if _, err := o.Take(); !errors.Is(err, ErrNoneOptional) {
+			t.Error("Unexpected Take:", name, err)
+		}
+		ShouldPanic(t, func() { o.Unwrap() }, true)
+		if old := o.Swap(2); old != 0 {
+			t.Error("Unexpected Swap:", name, old)
+		}
+	}
+}
+
 func ShouldPanic(t *testing.T, fn func(), p bool) {
 	defer func() {
 		panicked := false
@@ -358,3 +941,12 @@ func ShouldPanic(t *testing.T, fn func(), p bool) {
 	}()
 	fn()
 }
+
+// SkipNoReflect skips the test when built with opzione_noreflect, in which
+// nested references are not tracked.
+func SkipNoReflect(t *testing.T) {
+	t.Helper()
+	if noreflect {
+		t.Skip("nested references are not tracked with opzione_noreflect")
+	}
+}
@@ -0,0 +1,56 @@
+package opzione
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip combines two optionals into an Option of Pair, which is None if
+// either of them contains no value.
+func Zip[A, B any](a Optional[A], b Optional[B]) *Option[Pair[A, B]] {
+	if a.IsNone() || b.IsNone() {
+		return None[Pair[A, B]]()
+	}
+	return Some(Pair[A, B]{a.Unwrap(), b.Unwrap()})
+}
+
+// Zip3 combines three optionals into an Option of Triple, which is None if
+// any of them contains no value.
+func Zip3[A, B, C any](a Optional[A], b Optional[B], c Optional[C]) *Option[Triple[A, B, C]] {
+	if a.IsNone() || b.IsNone() || c.IsNone() {
+		return None[Triple[A, B, C]]()
+	}
+	return Some(Triple[A, B, C]{a.Unwrap(), b.Unwrap(), c.Unwrap()})
+}
+
+// Unpack2 destructures an optional Pair, reporting whether it contains a
+// value, so that it can be consumed with the comma-ok idiom:
+//
+//	if a, b, ok := Unpack2(Zip(x, y)); ok {
+//		...
+//	}
+func Unpack2[A, B any](o Optional[Pair[A, B]]) (a A, b B, ok bool) {
+	if o.IsNone() {
+		return
+	}
+	p := o.Unwrap()
+	return p.First, p.Second, true
+}
+
+// Unpack3 destructures an optional Triple, reporting whether it contains
+// a value.
+func Unpack3[A, B, C any](o Optional[Triple[A, B, C]]) (a A, b B, c C, ok bool) {
+	if o.IsNone() {
+		return
+	}
+	t := o.Unwrap()
+	return t.First, t.Second, t.Third, true
+}
@@ -0,0 +1,20 @@
+package opzione
+
+import "testing"
+
+func TestUnpack(t *testing.T) {
+	if a, b, ok := Unpack2[int, string](Zip[int, string](Some(1), Some("b"))); !ok || a != 1 || b != "b" {
+		t.Error("Unexpected result:", a, b, ok)
+	}
+	if _, _, ok := Unpack2[int, string](Zip[int, string](Some(1), None[string]())); ok {
+		t.Error("Unexpected Some")
+	}
+
+	zipped := Zip3[int, string, bool](Some(1), Some("b"), Some(true))
+	if a, b, c, ok := Unpack3[int, string, bool](zipped); !ok || a != 1 || b != "b" || !c {
+		t.Error("Unexpected result:", a, b, c, ok)
+	}
+	if _, _, _, ok := Unpack3[int, string, bool](None[Triple[int, string, bool]]()); ok {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,50 @@
+package opzione
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// NonePanicError is the value of panics raised by the package, such as by
+// Unwrap when there is no meaningful value, or by Some when the value is
+// nil. Err is ErrNoneOptional, possibly wrapping the cause recorded by Try
+// or the error of a Result, ErrNilValue, or ErrReleased.
+type NonePanicError struct {
+	// Type is the name of the type parameter of the optional.
+	Type string
+	Err  error
+}
+
+func (e *NonePanicError) Error() string {
+	return fmt.Sprintf("%v (%s)", e.Err, e.Type)
+}
+
+func (e *NonePanicError) Unwrap() error {
+	return e.Err
+}
+
+var panicHandler atomic.Pointer[func(error)]
+
+// SetPanicHandler sets a function which is called with the *NonePanicError
+// before the package panics, for instance to convert it into a crash report,
+// or to panic with a different value. If f returns, the package panics as
+// usual. A nil f removes the handler.
+func SetPanicHandler(f func(error)) {
+	if f == nil {
+		panicHandler.Store(nil)
+		return
+	}
+	panicHandler.Store(&f)
+}
+
+// Raise panics with a *NonePanicError for T and err, calling the handler set
+// by SetPanicHandler first. It is how every panic of the package is raised,
+// and is exported so that implementations of Optional elsewhere, such as the
+// ones generated by opzione-gen, can panic the same way.
+func Raise[T any](err error) {
+	e := &NonePanicError{Type: typeName[T](), Err: err}
+	if f := panicHandler.Load(); f != nil {
+		(*f)(e)
+	}
+	panic(e)
+}
@@ -0,0 +1,58 @@
+package opzione
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestNonePanicError(t *testing.T) {
+	recovered := func(f func()) (err *NonePanicError) {
+		defer func() {
+			err, _ = recover().(*NonePanicError)
+		}()
+		f()
+		return
+	}
+
+	err := recovered(func() { None[int]().Unwrap() })
+	if err == nil || err.Type != "int" || !errors.Is(err, ErrNoneOptional) {
+		t.Error("Unexpected panic:", err)
+	}
+
+	err = recovered(func() { Some[*string](nil) })
+	if err == nil || err.Type != "*string" || !errors.Is(err, ErrNilValue) {
+		t.Error("Unexpected panic:", err)
+	}
+
+	err = recovered(func() { Try(strconv.Atoi("a")).Unwrap() })
+	if err == nil || !errors.Is(err, strconv.ErrSyntax) {
+		t.Error("Unexpected panic:", err)
+	}
+
+	err = recovered(func() { Err[int](strconv.ErrRange).Unwrap() })
+	if err == nil || !errors.Is(err, ErrNoneOptional) || !errors.Is(err, strconv.ErrRange) {
+		t.Error("Unexpected panic:", err)
+	}
+
+	err = recovered(func() { Err[int](nil) })
+	if err == nil || err.Type != "int" || !errors.Is(err, ErrNilValue) {
+		t.Error("Unexpected panic:", err)
+	}
+
+	err = recovered(func() { FromOption[int](None[int](), nil) })
+	if err == nil || !errors.Is(err, ErrNilValue) {
+		t.Error("Unexpected panic:", err)
+	}
+}
+
+func TestSetPanicHandler(t *testing.T) {
+	var handled error
+	SetPanicHandler(func(err error) { handled = err })
+	defer SetPanicHandler(nil)
+
+	ShouldPanic(t, func() { new(Val[int]).Unwrap() }, true)
+	if !errors.Is(handled, ErrNoneOptional) {
+		t.Error("Unexpected error:", handled)
+	}
+}
@@ -0,0 +1,227 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Diff compares old and new, and returns a patch P whose Option fields are
+// Some with the values in new of the corresponding fields of T which differ,
+// as reported by reflect.DeepEqual, and None otherwise. Together with Merge
+// or ApplyPatch, it enables audit logs and minimal updates:
+//
+//	type User struct {
+//		Name  string
+//		Email string
+//	}
+//
+//	type UserPatch struct {
+//		Name  opzione.Option[string]
+//		Email opzione.Option[string]
+//	}
+//
+//	patch, err := opzione.Diff[User, UserPatch](old, new)
+//
+// Fields of P are matched with fields of T by name, including those of nested
+// struct fields, which are not pointers; their types must be the same as the
+// type parameters of the Options. Diff returns an error if a field of P has
+// no counterpart in T. T and P must be struct types.
+//
+// A field changed to nil, such as a nil pointer or interface, results in
+// None, which an Option field cannot tell apart from an unchanged field. An
+// *Option field can, as it is left nil if the field is unchanged, and is a
+// non-nil None if the field is changed to nil, like those decoded from null
+// by DecodePatch.
+func Diff[T, P any](old, new T) (patch P, err error) {
+	o, n, p := reflect.ValueOf(old), reflect.ValueOf(new), reflect.ValueOf(&patch).Elem()
+	if err = checkStructs(o.Type(), p.Type()); err != nil {
+		return
+	}
+
+	for _, f := range optionFields(p.Type()) {
+		sf, err := matchField(o.Type(), f)
+		if err != nil {
+			return patch, err
+		}
+		ov, nv := o.FieldByIndex(sf.Index).Interface(), n.FieldByIndex(sf.Index).Interface()
+		if !reflect.DeepEqual(ov, nv) {
+			setOptionField(p.FieldByIndex(f.Index), nv)
+		}
+	}
+	return patch, nil
+}
+
+// ApplyPatch stores the meaningful values of the Option fields of patch into
+// the corresponding fields of dst, which are matched as Diff does, and returns
+// the paths of the fields set, such as "Address.City", in the order of the
+// fields of P. They can be used to build the SET clause of an SQL statement,
+// or an audit record.
+//
+// A non-nil *Option field of patch which is None, as produced by Diff and
+// DecodePatch for fields changed to nil or null, sets the corresponding field
+// of dst to its zero value.
+//
+// If any field of P has no counterpart in T, or has a mismatching type,
+// ApplyPatch returns an error joining the conflicts, and leaves dst
+// untouched. T and P must be struct types.
+func ApplyPatch[T, P any](dst *T, patch P) (applied []string, err error) {
+	if dst == nil {
+		return nil, fmt.Errorf("opzione: cannot apply patch to nil %T", dst)
+	}
+	d, p := reflect.ValueOf(dst).Elem(), reflect.ValueOf(patch)
+	if err = checkStructs(d.Type(), p.Type()); err != nil {
+		return nil, err
+	}
+
+	fields := optionFields(p.Type())
+	targets := make([]reflect.StructField, len(fields))
+	var errs []error
+	for i, f := range fields {
+		if targets[i], err = matchField(d.Type(), f); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	for i, f := range fields {
+		field, target := p.FieldByIndex(f.Index), d.FieldByIndex(targets[i].Index)
+		v, ok := optionValue(field.Interface())
+		switch {
+		case ok:
+			target.Set(reflect.ValueOf(v))
+		case field.Kind() == reflect.Pointer && !field.IsNil():
+			target.SetZero()
+		default:
+			continue
+		}
+		applied = append(applied, f.Path)
+	}
+	return applied, nil
+}
+
+// DecodePatch decodes a JSON object from r into dst, a pointer to a struct
+// whose *Option fields distinguish absent members from null ones, which
+// json.Unmarshal cannot express:
+//
+//	type UserPatch struct {
+//		Name  *opzione.Option[string] `json:"name"`
+//		Email *opzione.Option[string] `json:"email"`
+//	}
+//
+// Given {"name": "a", "email": null}, Name becomes Some("a"), Email becomes
+// a non-nil None, meaning that the email should be cleared, and the *Option
+// fields of absent members are left untouched, typically nil. Members are
+// matched with fields as encoding/json does, by their json tags or names,
+// and nested objects are decoded likewise into struct fields, including
+// embedded ones. Other fields are decoded with encoding/json, so Nullable
+// fields can be used as well.
+func DecodePatch(r io.Reader, dst any) error {
+	val := reflect.ValueOf(dst)
+	if val.Kind() != reflect.Pointer || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("opzione: cannot decode patch into %T, which is not a pointer to struct", dst)
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&members); err != nil {
+		return err
+	}
+	return decodePatch(members, val.Elem())
+}
+
+func decodePatch(members map[string]json.RawMessage, val reflect.Value) error {
+	typ := val.Type()
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+		name, ok := jsonName(sf)
+		if !ok {
+			continue
+		}
+		field := val.Field(i)
+
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			if err := decodePatch(members, field); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		raw, ok := member(members, name)
+		if !ok {
+			continue
+		}
+
+		var err error
+		switch {
+		case sf.Type.Kind() == reflect.Pointer && isOptionType(sf.Type):
+			// Decode into a new Option, such that null results in a
+			// non-nil None.
+			p := reflect.New(sf.Type.Elem())
+			if err = p.Interface().(json.Unmarshaler).UnmarshalJSON(raw); err == nil {
+				field.Set(p)
+			}
+		case sf.Type.Kind() == reflect.Struct && !isOptionType(sf.Type) && !isJSONType(sf.Type):
+			var nested map[string]json.RawMessage
+			if err = json.Unmarshal(raw, &nested); err == nil {
+				err = decodePatch(nested, field)
+			}
+		default:
+			err = json.Unmarshal(raw, field.Addr().Interface())
+		}
+		if err != nil {
+			return fmt.Errorf("opzione: cannot decode %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// jsonName returns the name of sf in its json tag, which may be empty, and
+// whether sf is decoded at all.
+func jsonName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" || !sf.IsExported() && !sf.Anonymous {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name, true
+}
+
+// member returns the member of name, preferring an exact match to a
+// case-insensitive one as encoding/json does.
+func member(members map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if raw, ok := members[name]; ok {
+		return raw, true
+	}
+	for k, raw := range members {
+		if strings.EqualFold(k, name) {
+			return raw, true
+		}
+	}
+	return nil, false
+}
+
+// isJSONType reports whether typ implements json.Unmarshaler itself, such as
+// time.Time, so that it is not decoded as a nested object.
+func isJSONType(typ reflect.Type) bool {
+	return reflect.PointerTo(typ).Implements(reflect.TypeFor[json.Unmarshaler]())
+}
+
+// checkStructs returns an error if typ or patch is not a struct type.
+func checkStructs(typ, patch reflect.Type) error {
+	for _, t := range []reflect.Type{typ, patch} {
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("opzione: %v is not a struct", t)
+		}
+	}
+	return nil
+}
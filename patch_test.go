@@ -0,0 +1,210 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type patchAddress struct {
+	City string
+	Zip  string
+}
+
+type patchUser struct {
+	Name    string
+	Age     int
+	Tags    []string
+	Address patchAddress
+}
+
+type patchUserPatch struct {
+	Name    Option[string]
+	Age     *Option[int]
+	Tags    Option[[]string]
+	Address struct {
+		City Option[string]
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := patchUser{Name: "a", Age: 1, Tags: []string{"x"}, Address: patchAddress{City: "Rome", Zip: "1"}}
+	new := patchUser{Name: "a", Age: 2, Tags: []string{"x"}, Address: patchAddress{City: "Milan", Zip: "2"}}
+
+	patch, err := Diff[patchUser, patchUserPatch](old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !patch.Name.IsNone() || !patch.Tags.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if patch.Age.Unwrap() != 2 || patch.Address.City.Unwrap() != "Milan" {
+		t.Error("Unexpected patch:", patch.Age.Unwrap(), patch.Address.City.Unwrap())
+	}
+
+	var merged patchUserPatch
+	if err = Merge(&merged, patch); err != nil || merged.Age.Unwrap() != 2 {
+		t.Error("Unexpected Merge result:", err)
+	}
+
+	type mismatch struct{ Age Option[string] }
+	if _, err = Diff[patchUser, mismatch](old, new); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	type missing struct{ Email Option[string] }
+	if _, err = Diff[patchUser, missing](old, new); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if _, err = Diff[int, missing](1, 2); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestDiff_Nil(t *testing.T) {
+	type record struct {
+		Err  error
+		Note *string
+		Meta any
+	}
+	type recordPatch struct {
+		Err  *Option[error]
+		Note *Option[*string]
+		Meta Option[any]
+	}
+
+	note := "n"
+	old := record{Err: os.ErrNotExist, Note: &note, Meta: 1}
+	patch, err := Diff[record, recordPatch](old, record{Meta: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patch.Err == nil || !patch.Err.IsNone() || patch.Note == nil || !patch.Note.IsNone() {
+		t.Error("Unexpected patch:", patch.Err, patch.Note)
+	}
+	if !patch.Meta.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	applied, err := ApplyPatch(&old, patch)
+	if err != nil || !slices.Equal(applied, []string{"Err", "Note"}) {
+		t.Error("Unexpected applied fields:", applied, err)
+	}
+	if old.Err != nil || old.Note != nil || old.Meta != 1 {
+		t.Error("Unexpected result:", old)
+	}
+
+	if patch, err = Diff[record, recordPatch](old, old); err != nil || patch.Err != nil || patch.Note != nil {
+		t.Error("Unexpected patch:", patch.Err, patch.Note, err)
+	}
+	if patch, err = Diff[record, recordPatch](old, record{Meta: nil}); err != nil || !patch.Meta.IsNone() {
+		t.Error("Unexpected patch:", patch.Meta, err)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	dst := patchUser{Name: "a", Age: 1, Address: patchAddress{City: "Rome", Zip: "1"}}
+
+	var patch patchUserPatch
+	patch.Age = Some(2)
+	patch.Tags = S([]string{"x"})
+	patch.Address.City = S("Milan")
+
+	applied, err := ApplyPatch(&dst, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(applied, []string{"Age", "Tags", "Address.City"}) {
+		t.Error("Unexpected applied fields:", applied)
+	}
+	if dst.Name != "a" || dst.Age != 2 || dst.Tags[0] != "x" || dst.Address != (patchAddress{"Milan", "1"}) {
+		t.Error("Unexpected result:", dst)
+	}
+
+	type conflicting struct {
+		Name  Option[string]
+		Age   Option[string]
+		Email Option[string]
+	}
+	applied, err = ApplyPatch(&dst, conflicting{Name: S("b")})
+	if err == nil || applied != nil || dst.Name != "a" {
+		t.Error("Unexpected result:", applied, err)
+	}
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Error("Unexpected number of conflicts:", n)
+	}
+}
+
+func TestDecodePatch(t *testing.T) {
+	type base struct {
+		ID *Option[int] `json:"id"`
+	}
+	type body struct {
+		base
+		Name    *Option[string] `json:"name"`
+		Email   *Option[string] `json:"email"`
+		Phone   *Option[string] `json:"phone"`
+		Age     Option[int]
+		Created time.Time `json:"created"`
+		Address struct {
+			City *Option[string] `json:"city"`
+			Zip  *Option[string] `json:"zip"`
+		} `json:"address"`
+		Ignored string `json:"-"`
+	}
+
+	var b body
+	err := DecodePatch(strings.NewReader(`{
+		"id": 1,
+		"name": "a",
+		"email": null,
+		"AGE": 3,
+		"created": "2024-01-01T00:00:00Z",
+		"address": {"zip": null},
+		"Ignored": "x"
+	}`), &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.ID.Unwrap() != 1 || b.Name.Unwrap() != "a" || b.Age.Unwrap() != 3 {
+		t.Error("Unexpected values")
+	}
+	if b.Email == nil || !b.Email.IsNone() {
+		t.Error("Unexpected email:", b.Email)
+	}
+	if b.Phone != nil || b.Address.City != nil {
+		t.Error("Unexpected absent members")
+	}
+	if b.Address.Zip == nil || !b.Address.Zip.IsNone() {
+		t.Error("Unexpected zip:", b.Address.Zip)
+	}
+	if b.Created.Year() != 2024 || b.Ignored != "" {
+		t.Error("Unexpected other fields:", b.Created, b.Ignored)
+	}
+
+	if err = DecodePatch(strings.NewReader(`{"name": 1}`), &b); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if err = DecodePatch(strings.NewReader(`{}`), b); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestDecodePatch_Nullable(t *testing.T) {
+	type body struct {
+		Name  Nullable[string] `json:"name,omitzero"`
+		Email Nullable[string] `json:"email,omitzero"`
+	}
+
+	var p body
+	if err := DecodePatch(strings.NewReader(`{"email":null}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Name.IsUnset() || !p.Email.IsNull() {
+		t.Error("Unexpected states:", p)
+	}
+}
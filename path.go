@@ -0,0 +1,106 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Path traverses obj along path, a dot-separated list of struct field names,
+// map keys and slice indices, and returns an Option of the value found.
+// Pointers, interfaces and Options are followed along the way. The result is
+// None if any step cannot be taken, such as a nil pointer, a missing key or
+// an unexported field, or if the value found is nil.
+//
+//	opzione.Path(config, "Servers.0.Labels.region")
+func Path(obj any, path string) *Option[any] {
+	val := reflect.ValueOf(obj)
+	if path != "" {
+		for _, name := range strings.Split(path, ".") {
+			if val = step(val, name); !val.IsValid() {
+				return None[any]()
+			}
+		}
+	}
+	if !val.IsValid() || !val.CanInterface() {
+		return None[any]()
+	}
+	v := val.Interface()
+	if isOptionType(val.Type()) {
+		var ok bool
+		if v, ok = optionValue(v); !ok {
+			return None[any]()
+		}
+	}
+	return FromPointer(&v)
+}
+
+// step returns the element of val named name, or the zero Value if there is
+// none.
+func step(val reflect.Value, name string) reflect.Value {
+	val = deref(val)
+	if !val.IsValid() {
+		return reflect.Value{}
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		f, ok := val.Type().FieldByName(name)
+		if !ok || !f.IsExported() {
+			return reflect.Value{}
+		}
+		field, err := val.FieldByIndexErr(f.Index)
+		if err != nil {
+			// An embedded struct pointer is nil.
+			return reflect.Value{}
+		}
+		return field
+	case reflect.Map:
+		key := reflect.ValueOf(name)
+		if kt := val.Type().Key(); kt.Kind() != reflect.String {
+			if !isIntKind(kt.Kind()) {
+				return reflect.Value{}
+			}
+			i, err := strconv.ParseInt(name, 10, 64)
+			if err != nil || reflect.Zero(kt).OverflowInt(i) {
+				return reflect.Value{}
+			}
+			key = reflect.ValueOf(i)
+		}
+		return val.MapIndex(key.Convert(val.Type().Key()))
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(name)
+		if err != nil || i < 0 || i >= val.Len() {
+			return reflect.Value{}
+		}
+		return val.Index(i)
+	default:
+		return reflect.Value{}
+	}
+}
+
+// deref follows pointers, interfaces and Options until a value of another
+// kind, returning the zero Value if a nil or None is reached.
+func deref(val reflect.Value) reflect.Value {
+	for val.IsValid() {
+		switch {
+		case val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface:
+			val = val.Elem()
+		case val.CanInterface() && isOptionType(val.Type()):
+			v, ok := optionValue(val.Interface())
+			if !ok {
+				return reflect.Value{}
+			}
+			val = reflect.ValueOf(v)
+		default:
+			return val
+		}
+	}
+	return val
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
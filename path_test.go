@@ -0,0 +1,51 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "testing"
+
+func TestPath(t *testing.T) {
+	manager := &lensUser{Name: "b", Address: &lensAddress{City: "Milan"}}
+	user := &lensUser{
+		Name:    "a",
+		Address: &lensAddress{City: "Rome", Labels: map[string]string{"region": "Lazio"}},
+		Phones:  []string{"1", "2"},
+		Manager: Some(manager),
+		secret:  "s",
+	}
+
+	cases := map[string]any{
+		"Name":                  "a",
+		"Address.City":          "Rome",
+		"Address.Labels.region": "Lazio",
+		"Phones.1":              "2",
+		"Manager.Address.City":  "Milan",
+		"Manager.Name":          "b",
+	}
+	for path, want := range cases {
+		if v, err := Path(user, path).Value(); err != nil || v != want {
+			t.Errorf("Unexpected value at %s: %v, %v", path, v, err)
+		}
+	}
+
+	if _, ok := Path(user, "Manager").Unwrap().(*lensUser); !ok {
+		t.Error("Unexpected Manager")
+	}
+
+	for _, path := range []string{
+		"Missing", "secret", "Phones.2", "Phones.x", "Address.Labels.city",
+		"Manager.Manager.Name", "Manager.Address.Labels.region", "Name.Length",
+	} {
+		if !Path(user, path).IsNone() {
+			t.Error("Unexpected Some at", path)
+		}
+	}
+	if !Path(nil, "").IsNone() || Path(user, "").IsNone() {
+		t.Error("Unexpected result for empty path")
+	}
+
+	ints := map[int]string{1: "a"}
+	if Path(ints, "1").Unwrap() != "a" || !Path(ints, "a").IsNone() {
+		t.Error("Unexpected result for int keys")
+	}
+}
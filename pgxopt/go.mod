@@ -0,0 +1,8 @@
+module github.com/oissevalt/opzione/pgxopt
+
+go 1.25.0
+
+require (
+	github.com/jackc/pgx/v5 v5.11.0
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+)
@@ -0,0 +1,167 @@
+// Package pgxopt provides optional values which can be used as query
+// arguments and scan destinations by github.com/jackc/pgx/v5. It is a
+// separate module, so that opzione does not depend on pgx.
+package pgxopt
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oissevalt/opzione"
+)
+
+// firstUserOID is the first OID assigned to user-defined objects by
+// PostgreSQL; lower OIDs are reserved for built-in types.
+const firstUserOID = 16384
+
+// Register registers Option with m, so that Options can be used as query
+// arguments and scan destinations by pgx, including within arrays and in the
+// binary format. The contained value is encoded and decoded as pgx would do
+// for T, and NULL corresponds to None. Register is typically called once
+// for every connection:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxopt.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// Built-in types of m are covered; types registered later, such as enums and
+// composite types loaded with LoadType, must be registered with RegisterType
+// instead of Map.RegisterType.
+func Register(m *pgtype.Map) {
+	m.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{tryWrapEncodePlan}, m.TryWrapEncodePlanFuncs...)
+	for oid := range uint32(firstUserOID) {
+		if t, ok := m.TypeForOID(oid); ok {
+			RegisterType(m, t)
+		}
+	}
+}
+
+// RegisterType registers t with m, as Map.RegisterType does, so that
+// Options of values of t can be encoded and decoded. t is not modified.
+func RegisterType(m *pgtype.Map, t *pgtype.Type) {
+	if _, ok := t.Codec.(codec); ok {
+		m.RegisterType(t)
+		return
+	}
+	m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: codec{t.Codec}})
+}
+
+// Option is an opzione.Option which pgx encodes and scans as T, with NULL
+// corresponding to None.
+type Option[T any] struct {
+	opzione.Option[T]
+}
+
+// Some returns an Option containing v, panicking as opzione.Some does if v
+// is nil.
+func Some[T any](v T) Option[T] {
+	return Option[T]{opzione.S(v)}
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{opzione.N[T]()}
+}
+
+// encoder and scanner are implemented by Option, so that encoding and
+// scanning can be planned without knowing T.
+type (
+	encoder interface {
+		planEncode() (plan pgtype.WrappedEncodePlanNextSetter, next any)
+	}
+	scanner interface {
+		planScan(m *pgtype.Map, oid uint32, format int16) pgtype.ScanPlan
+	}
+)
+
+// codec wraps a codec, planning Options before the codec does. Otherwise,
+// Options would be scanned as sql.Scanner, which loses information for
+// types such as arrays.
+type codec struct {
+	pgtype.Codec
+}
+
+func (c codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if o, ok := value.(encoder); ok {
+		plan, next := o.planEncode()
+		nextPlan := m.PlanEncode(oid, format, next)
+		if nextPlan == nil {
+			return nil
+		}
+		plan.SetNext(nextPlan)
+		return plan
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+func (c codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if o, ok := target.(scanner); ok {
+		return o.planScan(m, oid, format)
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+// tryWrapEncodePlan plans encoding Options whose OID is unknown, as with
+// the simple protocol.
+func tryWrapEncodePlan(value any) (plan pgtype.WrappedEncodePlanNextSetter, next any, ok bool) {
+	if o, ok := value.(encoder); ok {
+		plan, next = o.planEncode()
+		return plan, next, true
+	}
+	return nil, nil, false
+}
+
+func (Option[T]) planEncode() (pgtype.WrappedEncodePlanNextSetter, any) {
+	var t T
+	return &encodePlan[T]{}, t
+}
+
+func (*Option[T]) planScan(m *pgtype.Map, oid uint32, format int16) pgtype.ScanPlan {
+	return &scanPlan[T]{next: m.PlanScan(oid, format, new(T))}
+}
+
+type encodePlan[T any] struct {
+	next pgtype.EncodePlan
+}
+
+func (p *encodePlan[T]) SetNext(next pgtype.EncodePlan) {
+	p.next = next
+}
+
+// Encode encodes the contained value, or NULL if there is none.
+func (p *encodePlan[T]) Encode(value any, buf []byte) ([]byte, error) {
+	var o *Option[T]
+	switch v := value.(type) {
+	case Option[T]:
+		o = &v
+	case *Option[T]:
+		o = v
+	}
+	if o == nil {
+		return nil, nil
+	}
+	v, ok := o.Get()
+	if !ok {
+		return nil, nil
+	}
+	return p.next.Encode(v, buf)
+}
+
+type scanPlan[T any] struct {
+	next pgtype.ScanPlan
+}
+
+// Scan scans src into the Option, which becomes None if src is NULL.
+func (p *scanPlan[T]) Scan(src []byte, target any) error {
+	o := target.(*Option[T])
+	if src == nil {
+		_, _ = o.Take()
+		return nil
+	}
+
+	var v T
+	if err := p.next.Scan(src, &v); err != nil {
+		return err
+	}
+	o.Swap(v)
+	return nil
+}
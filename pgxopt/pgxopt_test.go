@@ -0,0 +1,127 @@
+package pgxopt
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Interface assertions
+var (
+	_ pgtype.Codec                       = codec{}
+	_ encoder                            = Option[int]{}
+	_ scanner                            = &Option[int]{}
+	_ pgtype.ScanPlan                    = &scanPlan[int]{}
+	_ pgtype.WrappedEncodePlanNextSetter = &encodePlan[int]{}
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestOption(t *testing.T) {
+	m := newMap()
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.Int4OID, format, Some[int32](42), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var o Option[int32]
+		if err = m.Scan(pgtype.Int4OID, format, buf, &o); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := o.Value(); err != nil || v != 42 {
+			t.Error("Unexpected value:", format, v, err)
+		}
+
+		buf, err = m.Encode(pgtype.Int4OID, format, None[int32](), nil)
+		if err != nil || buf != nil {
+			t.Error("Unexpected encoding of None:", format, buf, err)
+		}
+		if err = m.Scan(pgtype.Int4OID, format, nil, &o); err != nil {
+			t.Fatal(err)
+		}
+		if !o.IsNone() {
+			t.Error("Unexpected value scanned from NULL:", format, o)
+		}
+	}
+
+	hello := Some("hello")
+	buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, &hello, nil)
+	if err != nil || string(buf) != "hello" {
+		t.Error("Unexpected encoding of pointer:", string(buf), err)
+	}
+	buf, err = m.Encode(pgtype.TextOID, pgtype.TextFormatCode, (*Option[string])(nil), nil)
+	if err != nil || buf != nil {
+		t.Error("Unexpected encoding of nil:", buf, err)
+	}
+	buf, err = m.Encode(0, pgtype.TextFormatCode, Some[int64](7), nil)
+	if err != nil || string(buf) != "7" {
+		t.Error("Unexpected encoding without OID:", string(buf), err)
+	}
+}
+
+func TestOption_Timestamp(t *testing.T) {
+	m := newMap()
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	buf, err := m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, Some(ts), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o Option[time.Time]
+	if err = m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &o); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := o.Value(); err != nil || !v.Equal(ts) {
+		t.Error("Unexpected timestamp:", v, err)
+	}
+}
+
+func TestOption_Array(t *testing.T) {
+	m := newMap()
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.Int4ArrayOID, format, Some([]int32{1, 2, 3}), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var o Option[[]int32]
+		if err = m.Scan(pgtype.Int4ArrayOID, format, buf, &o); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := o.Value(); err != nil || !slices.Equal(v, []int32{1, 2, 3}) {
+			t.Error("Unexpected array:", format, v, err)
+		}
+
+		elems := []Option[int32]{Some[int32](1), None[int32](), Some[int32](3)}
+		buf, err = m.Encode(pgtype.Int4ArrayOID, format, elems, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var scanned []Option[int32]
+		if err = m.Scan(pgtype.Int4ArrayOID, format, buf, &scanned); err != nil {
+			t.Fatal(err)
+		}
+		if len(scanned) != 3 || scanned[0].Unwrap() != 1 || !scanned[1].IsNone() || scanned[2].Unwrap() != 3 {
+			t.Error("Unexpected elements:", format, scanned)
+		}
+	}
+}
+
+func TestRegisterType(t *testing.T) {
+	m := newMap()
+	dt, _ := m.TypeForOID(pgtype.Int8OID)
+	RegisterType(m, dt)
+	if dt, _ = m.TypeForOID(pgtype.Int8OID); dt.Codec.(codec).Codec == nil {
+		t.Error("Unexpected codec:", dt.Codec)
+	}
+	if _, ok := dt.Codec.(codec).Codec.(codec); ok {
+		t.Error("Unexpected double wrapping")
+	}
+}
@@ -0,0 +1,27 @@
+package opzione
+
+// plan describes how an Option of a type checks its value, so that values
+// of the type need not be classified with reflection one by one.
+type plan struct {
+	ptrtyp bool
+	track  bool
+	direct bool
+
+	// dynamic is set for interface types, whose values must be classified
+	// individually because the dynamic type is unknown in advance.
+	dynamic bool
+}
+
+// setplan sets how the Option checks its value.
+func (o *Option[T]) setplan(p plan) {
+	o.ptrtyp, o.track, o.direct = p.ptrtyp, p.track, p.direct
+}
+
+// trackSettings returns the depth set by SetTrackDepth, and whether typed
+// nils are detected as set by DetectTypedNil.
+func (o *Option[T]) trackSettings() (depth int, typed bool) {
+	if m := o.loadMeta(); m != nil {
+		return m.depth, !m.untyped
+	}
+	return 0, true
+}
@@ -0,0 +1,49 @@
+//go:build opzione_noreflect
+
+package opzione
+
+// Without reflection, types cannot be classified, so Options do not track
+// references, and only the topmost reference is checked by comparing it to
+// nil directly.
+
+// noreflect reports whether the package is built without reflection.
+const noreflect = true
+
+// planFor returns the plan for T, which never tracks references.
+func planFor[T any]() plan {
+	return plan{}
+}
+
+// classify returns the plan for the value v points to, and whether it is
+// nil.
+func classify[T any](v *T) (p plan, null bool) {
+	return plan{}, isnilany(*v)
+}
+
+// isnull reports whether the contained value, which must be present, is nil.
+// Typed nils in interface-typed T are detected unless disabled.
+func (o *Option[T]) isnull() bool {
+	if _, typed := o.trackSettings(); !typed && isiface[T]() {
+		return any(*o.v) == nil
+	}
+	return isnilany(*o.v)
+}
+
+// isiface reports whether T is an interface type, whose zero value is the
+// only one converting to a nil any.
+func isiface[T any]() bool {
+	var zero T
+	return any(zero) == nil
+}
+
+// nildepth returns 0 if the contained value, which must be present, is nil,
+// or -1 otherwise.
+func (o *Option[T]) nildepth() int {
+	return nilif(o.isnull())
+}
+
+// newelem is unavailable without reflection, so it reports false.
+func newelem[U, T any](v *T) (U, bool) {
+	var u U
+	return u, false
+}
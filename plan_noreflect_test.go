@@ -0,0 +1,56 @@
+//go:build opzione_noreflect
+
+package opzione
+
+import (
+	"os"
+	"testing"
+	"unsafe"
+)
+
+func TestNoReflect(t *testing.T) {
+	i := 1
+	p := &i
+	option := Some(&p)
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	// Nested references are not tracked.
+	p = nil
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	// The topmost reference is still checked.
+	ShouldPanic(t, func() { Some[*int](nil) }, true)
+	ShouldPanic(t, func() { Some[map[int]int](nil) }, true)
+	if !FromPointer(new(*int)).IsNone() {
+		t.Error("Unexpected Some")
+	}
+	option.Swap(nil)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	// So are nil interfaces and typed nils.
+	if !FromPointer(new(any)).IsNone() {
+		t.Error("Unexpected Some")
+	}
+	ShouldPanic(t, func() { Some[any](nil) }, true)
+	ShouldPanic(t, func() { Some[error]((*os.PathError)(nil)) }, true)
+
+	// Values which are not pointer-shaped are never nil.
+	if Some(0).IsNone() || Some("").IsNone() || Some([]int(nil)).IsNone() || Some(struct{}{}).IsNone() {
+		t.Error("Unexpected None")
+	}
+
+	// Neither are structs and arrays holding a single nil pointer, although
+	// they are represented like one.
+	if Some(struct{ p *int }{}).IsNone() || Some([1]*int{}).IsNone() {
+		t.Error("Unexpected None")
+	}
+	ShouldPanic(t, func() { Some[func()](nil) }, true)
+	ShouldPanic(t, func() { Some[chan int](nil) }, true)
+	ShouldPanic(t, func() { Some[unsafe.Pointer](nil) }, true)
+}
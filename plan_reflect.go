@@ -0,0 +1,204 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// noreflect reports whether the package is built without reflection.
+const noreflect = false
+
+// plans caches the plan for each type.
+var plans sync.Map
+
+// planFor returns the plan for T, computing it on first use.
+func planFor[T any]() plan {
+	typ := reflect.TypeFor[T]()
+	if p, ok := plans.Load(typ); ok {
+		return p.(plan)
+	}
+	var p plan
+	p.ptrtyp, p.track = typemode(typ)
+	p.direct = p.ptrtyp && isdirect(typ, p.track)
+	p.dynamic = typ.Kind() == reflect.Interface
+	plans.Store(typ, p)
+	return p
+}
+
+// classify returns the plan for the value v points to, and whether it is nil
+// or dereferences to nil.
+func classify[T any](v *T) (p plan, null bool) {
+	p = planFor[T]()
+	switch {
+	case p.dynamic:
+		val := reflect.ValueOf(*v)
+		if !val.IsValid() {
+			return p, true
+		}
+		// The plan still tracks the value, so that IsNone checks the
+		// dynamic value at the time, however it has been stored.
+		return p, isptrkind(val.Kind()) && isnil(val)
+	case p.direct:
+		return p, *(*unsafe.Pointer)(unsafe.Pointer(v)) == nil
+	case p.ptrtyp:
+		return p, isnil(reflect.ValueOf(*v))
+	default:
+		return p, false
+	}
+}
+
+// isnull reports whether the contained value, which must be present, is nil
+// or dereferences to nil.
+func (o *Option[T]) isnull() bool {
+	switch {
+	case o.direct:
+		// T is a single machine pointer, which can be compared directly
+		// without reflection.
+		return *(*unsafe.Pointer)(unsafe.Pointer(o.v)) == nil
+	case o.track:
+		// Follow the whole reference chain in a single pass, starting from
+		// the interface itself if T is an interface type, so that typed
+		// nils are detected only as configured.
+		depth, typed := o.trackSettings()
+		return isniln(reflect.ValueOf(o.v).Elem(), depth, typed)
+	default:
+		return false
+	}
+}
+
+// nildepth returns the number of references followed until nil is reached
+// in the contained value, which must be present, or -1 if it is not nil.
+func (o *Option[T]) nildepth() int {
+	switch {
+	case o.track:
+		depth, typed := o.trackSettings()
+		return nilat(reflect.ValueOf(o.v).Elem(), depth, typed)
+	default:
+		return nilif(o.isnull())
+	}
+}
+
+// newelem allocates the element of T into *v if T is a pointer type whose
+// element implements U, returning the new pointer as U.
+func newelem[U, T any](v *T) (U, bool) {
+	var u U
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Pointer {
+		return u, false
+	}
+	p := reflect.New(typ.Elem())
+	u, ok := p.Interface().(U)
+	if ok {
+		reflect.ValueOf(v).Elem().Set(p)
+	}
+	return u, ok
+}
+
+// isnilany reports whether x is nil or dereferences to nil.
+func isnilany(x any) bool {
+	return isnil(reflect.ValueOf(x))
+}
+
+// isdirect reports whether values of typ are represented as a single machine
+// pointer whose nilness is all that needs to be checked, given whether they
+// are tracked.
+func isdirect(typ reflect.Type, track bool) bool {
+	switch typ.Kind() {
+	case reflect.UnsafePointer, reflect.Map, reflect.Chan, reflect.Func:
+		// Tracking never goes beyond the reference itself for these kinds.
+		return true
+	case reflect.Pointer:
+		return !track
+	default:
+		return false
+	}
+}
+
+// typemode reports whether values of typ are pointer-like, and whether they
+// need to be tracked recursively, without inspecting any value.
+func typemode(typ reflect.Type) (ptrtyp, track bool) {
+	switch typ.Kind() {
+	case reflect.UnsafePointer:
+		return true, false
+	case reflect.Pointer:
+		return true, isptrkind(typ.Elem().Kind())
+	case reflect.Func, reflect.Map, reflect.Chan, reflect.Interface:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+func isptrkind(kind reflect.Kind) bool {
+	return kind == reflect.UnsafePointer ||
+		kind == reflect.Pointer ||
+		kind == reflect.Func ||
+		kind == reflect.Map ||
+		kind == reflect.Chan ||
+		kind == reflect.Interface
+}
+
+func isnil(val reflect.Value) bool {
+	return isniln(val, 0, true)
+}
+
+// isniln is like isnil, but follows at most n references if n is positive,
+// and looks into non-nil interfaces only if typed is true.
+func isniln(val reflect.Value, n int, typed bool) bool {
+	return nilat(val, n, typed) >= 0
+}
+
+// nilat is like isniln, but returns the number of references followed until
+// nil is reached, or -1 if val is not nil.
+func nilat(val reflect.Value, n int, typed bool) int {
+	if !val.IsValid() {
+		// val is constructed from empty Value{}, nil, or is corrupted.
+		return 0
+	}
+
+	switch val.Kind() {
+	case reflect.UnsafePointer:
+		// An unsafe pointer can be anything; the package is only responsible
+		// for checking the shallowest reference.
+		return nilif(val.UnsafePointer() == nil)
+	case reflect.Pointer:
+		elem := val.Elem()
+		if !elem.IsValid() {
+			// The pointer itself is nil.
+			return 0
+		}
+		if n == 1 {
+			return -1
+		}
+		// Continue this process with the pointed object.
+		if d := nilat(elem, n-1, typed); d >= 0 {
+			return d + 1
+		}
+		return -1
+	case reflect.Interface:
+		if val.IsNil() {
+			return 0
+		}
+		// A non-nil interface may still wrap a nil pointer, which is known
+		// as a typed nil. The interface is regarded as the same reference.
+		elem := val.Elem()
+		if !typed || !isptrkind(elem.Kind()) {
+			return -1
+		}
+		return nilat(elem, n, typed)
+	case reflect.Func, reflect.Map, reflect.Chan:
+		// These are pointer-like types. They can be nil and calling a nil
+		// value may trigger a runtime panic.
+		return nilif(val.IsNil())
+	case reflect.Slice:
+		// A nil slice is safe to use. In the context of this package, we
+		// don't consider it purely "nil" as opposed to a pointer.
+		return -1
+	default:
+		// Value types; cannot be nil.
+		return -1
+	}
+}
@@ -0,0 +1,43 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "testing"
+
+func TestPlanFor(t *testing.T) {
+	if p := planFor[int](); p.ptrtyp || p.track || p.direct || p.dynamic {
+		t.Error("Unexpected plan:", p)
+	}
+	if p := planFor[*int](); !p.ptrtyp || p.track || !p.direct {
+		t.Error("Unexpected plan:", p)
+	}
+	if p := planFor[**int](); !p.ptrtyp || !p.track || p.direct {
+		t.Error("Unexpected plan:", p)
+	}
+	if p := planFor[any](); !p.dynamic || p.direct {
+		t.Error("Unexpected plan:", p)
+	}
+	if planFor[*int]() != planFor[*int]() {
+		t.Error("Unexpected plan mismatch")
+	}
+
+	i := 1
+	p := &i
+	if _, null := classify(&p); null {
+		t.Error("Unexpected nil")
+	}
+	var a any = p
+	if p, null := classify(&a); null || !p.ptrtyp || !p.track {
+		t.Error("Unexpected plan:", p)
+	}
+	a = nil
+	if _, null := classify(&a); !null {
+		t.Error("Unexpected non-nil")
+	}
+}
+
+func BenchmarkSomeValue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Some(i)
+	}
+}
@@ -0,0 +1,49 @@
+package opzione
+
+import "sync"
+
+// pools holds a *sync.Pool of *Option[T] for each T.
+var pools sync.Map
+
+func poolFor[T any]() *sync.Pool {
+	// A nil *T identifies T without reflection.
+	key := any((*T)(nil))
+	if p, ok := pools.Load(key); ok {
+		return p.(*sync.Pool)
+	}
+	p, _ := pools.LoadOrStore(key, &sync.Pool{
+		New: func() any { return new(Option[T]) },
+	})
+	return p.(*sync.Pool)
+}
+
+// AcquireOption returns an Option with no value from a per-type pool, as if
+// by None. The Option should be handed back with Release once it is no
+// longer used, to reduce allocations where many short-lived Options are
+// created.
+func AcquireOption[T any]() *Option[T] {
+	o := poolFor[T]().Get().(*Option[T])
+	o.setplan(planFor[T]())
+	o.traced()
+	return o
+}
+
+// Release resets the Option and returns it to the pool for AcquireOption.
+// The Option must not be used afterwards. When built with the opzione_debug
+// tag, Release does not recycle the Option, and any further use of it, or
+// releasing it again, panics.
+func (o *Option[T]) Release() {
+	o.checkReleased()
+	*o = Option[T]{}
+	if debug {
+		o.meta().released = true
+		return
+	}
+	poolFor[T]().Put(o)
+}
+
+func (o *Option[T]) checkReleased() {
+	if m := o.loadMeta(); debug && m != nil && m.released {
+		Raise[T](ErrReleased)
+	}
+}
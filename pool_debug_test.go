@@ -0,0 +1,24 @@
+//go:build opzione_debug
+
+package opzione
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReleaseDebug(t *testing.T) {
+	o := AcquireOption[int]()
+	o.Release()
+
+	ShouldPanic(t, func() { o.IsNone() }, true)
+	ShouldPanic(t, func() { o.Swap(1) }, true)
+	ShouldPanic(t, o.Release, true)
+
+	defer func() {
+		if err, _ := recover().(*NonePanicError); err == nil || !errors.Is(err, ErrReleased) {
+			t.Error("Unexpected panic:", err)
+		}
+	}()
+	o.IsNone()
+}
@@ -0,0 +1,23 @@
+package opzione
+
+import "testing"
+
+func TestAcquireOption(t *testing.T) {
+	o := AcquireOption[*int]()
+	if !o.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	i := 1
+	o.Swap(&i)
+	if *o.Unwrap() != 1 {
+		t.Error("Unexpected value:", o.Unwrap())
+	}
+	o.Release()
+
+	o = AcquireOption[*int]()
+	if !o.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	o.Release()
+}
@@ -0,0 +1,216 @@
+// Package prim provides optional types specialized for common primitive
+// types. They store the value inline and check its presence without
+// reflection, and support encoding/json and database/sql, so that they can
+// be used directly as fields of models.
+//
+//	type User struct {
+//		Name  string
+//		Email prim.String
+//		Age   prim.Int
+//	}
+//
+// The zero value of each type contains no value, and is encoded as null.
+package prim
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"iter"
+	"time"
+
+	"github.com/oissevalt/opzione"
+)
+
+// Interface assertions
+var (
+	_ opzione.Optional[int]       = (*Int)(nil)
+	_ opzione.Optional[int64]     = (*Int64)(nil)
+	_ opzione.Optional[float64]   = (*Float64)(nil)
+	_ opzione.Optional[string]    = (*String)(nil)
+	_ opzione.Optional[bool]      = (*Bool)(nil)
+	_ opzione.Optional[time.Time] = (*Time)(nil)
+)
+
+// Int is an optional int.
+type Int struct{ value[int] }
+
+// Int64 is an optional int64.
+type Int64 struct{ value[int64] }
+
+// Float64 is an optional float64.
+type Float64 struct{ value[float64] }
+
+// String is an optional string.
+type String struct{ value[string] }
+
+// Bool is an optional bool.
+type Bool struct{ value[bool] }
+
+// Time is an optional time.Time.
+type Time struct{ value[time.Time] }
+
+// SomeInt constructs an Int containing v.
+func SomeInt(v int) Int { return Int{some(v)} }
+
+// SomeInt64 constructs an Int64 containing v.
+func SomeInt64(v int64) Int64 { return Int64{some(v)} }
+
+// SomeFloat64 constructs a Float64 containing v.
+func SomeFloat64(v float64) Float64 { return Float64{some(v)} }
+
+// SomeString constructs a String containing v.
+func SomeString(v string) String { return String{some(v)} }
+
+// SomeBool constructs a Bool containing v.
+func SomeBool(v bool) Bool { return Bool{some(v)} }
+
+// SomeTime constructs a Time containing v.
+func SomeTime(v time.Time) Time { return Time{some(v)} }
+
+// value implements the methods shared by the types of the package.
+type value[T any] struct {
+	v    T
+	some bool
+}
+
+func some[T any](v T) value[T] {
+	return value[T]{v: v, some: true}
+}
+
+// IsNone reports whether there is no value.
+func (o *value[T]) IsNone() bool {
+	return !o.some
+}
+
+// IsSome reports whether there is a value.
+func (o *value[T]) IsSome() bool {
+	return o.some
+}
+
+// Value returns the contained value, or opzione.ErrNoneOptional if there is
+// none.
+func (o *value[T]) Value() (T, error) {
+	if !o.some {
+		return o.v, opzione.ErrNoneOptional
+	}
+	return o.v, nil
+}
+
+// Unwrap returns the contained value, panicking if there is none.
+func (o *value[T]) Unwrap() T {
+	if !o.some {
+		opzione.Raise[T](opzione.ErrNoneOptional)
+	}
+	return o.v
+}
+
+// Get returns the contained value, and whether there is one.
+func (o *value[T]) Get() (T, bool) {
+	return o.v, o.some
+}
+
+// Swap stores v and returns the original value, which is the zero value if
+// there was none.
+func (o *value[T]) Swap(v T) T {
+	t := o.v
+	o.v, o.some = v, true
+	return t
+}
+
+// Take moves the contained value out, leaving no value. It returns
+// opzione.ErrNoneOptional if there is no value.
+func (o *value[T]) Take() (*T, error) {
+	if !o.some {
+		return nil, opzione.ErrNoneOptional
+	}
+	t := o.v
+	*o = value[T]{}
+	return &t, nil
+}
+
+// With executes f with the contained value, if any.
+func (o *value[T]) With(f func(T)) {
+	if o.some {
+		f(o.v)
+	}
+}
+
+// WithNone executes f if there is no value.
+func (o *value[T]) WithNone(f func()) {
+	if !o.some {
+		f()
+	}
+}
+
+// Assign assigns a pointer to the contained value to *p, if there is one.
+func (o *value[T]) Assign(p **T) bool {
+	if o.some {
+		*p = &o.v
+	}
+	return o.some
+}
+
+// Iter returns an iterator yielding the contained value, if any.
+func (o *value[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.some {
+			yield(o.v)
+		}
+	}
+}
+
+// IsZero reports whether there is no value, so that fields tagged with
+// omitzero are omitted by encoding/json.
+func (o value[T]) IsZero() bool {
+	return !o.some
+}
+
+// MarshalJSON implements json.Marshaler. No value is encoded as null.
+func (o value[T]) MarshalJSON() ([]byte, error) {
+	if !o.some {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Null results in no value.
+func (o *value[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = value[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = some(v)
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL results in no value.
+func (o *value[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = value[T]{v: n.V, some: n.Valid}
+	return nil
+}
+
+// Valuer returns a driver.Valuer for the contained value, which is NULL if
+// there is none. The types cannot implement driver.Valuer themselves, since
+// their Value methods return the contained value.
+func (o value[T]) Valuer() driver.Valuer {
+	return valuer[T](o)
+}
+
+type valuer[T any] value[T]
+
+func (v valuer[T]) Value() (driver.Value, error) {
+	if !v.some {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v.v)
+}
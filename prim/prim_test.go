@@ -0,0 +1,85 @@
+package prim
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/oissevalt/opzione"
+)
+
+func TestPrim(t *testing.T) {
+	i := SomeInt(1)
+	if i.IsNone() || i.Unwrap() != 1 {
+		t.Error("Unexpected state")
+	}
+	if old := i.Swap(2); old != 1 || i.Unwrap() != 2 {
+		t.Error("Unexpected Swap result:", old)
+	}
+	if p, err := i.Take(); err != nil || *p != 2 || !i.IsNone() {
+		t.Error("Unexpected Take result:", err)
+	}
+
+	var s String
+	if _, ok := s.Get(); ok {
+		t.Error("Unexpected Some")
+	}
+
+	defer func() {
+		if err, _ := recover().(*opzione.NonePanicError); err == nil || err.Type != "string" {
+			t.Error("Unexpected panic:", err)
+		}
+	}()
+	s.Unwrap()
+}
+
+func TestPrim_JSON(t *testing.T) {
+	type model struct {
+		Name  String  `json:"name"`
+		Age   Int     `json:"age,omitzero"`
+		Score Float64 `json:"score"`
+	}
+
+	data, err := json.Marshal(model{Name: SomeString("a"), Score: SomeFloat64(1.5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"a","score":1.5}` {
+		t.Error("Unexpected JSON:", string(data))
+	}
+
+	var m model
+	if err = json.Unmarshal([]byte(`{"name":null,"age":3}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Name.IsNone() || m.Age.Unwrap() != 3 || !m.Score.IsNone() {
+		t.Error("Unexpected model:", m)
+	}
+}
+
+func TestPrim_SQL(t *testing.T) {
+	var i Int
+	if err := i.Scan(int64(4)); err != nil || i.Unwrap() != 4 {
+		t.Error("Unexpected Scan result:", err)
+	}
+	if v, err := i.Valuer().Value(); err != nil || v != int64(4) {
+		t.Error("Unexpected driver value:", v, err)
+	}
+	if err := i.Scan(nil); err != nil || !i.IsNone() {
+		t.Error("Unexpected Scan result:", err)
+	}
+	if v, err := i.Valuer().Value(); err != nil || v != nil {
+		t.Error("Unexpected driver value:", v, err)
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var tm Time
+	if err := tm.Scan(now); err != nil || !tm.Unwrap().Equal(now) {
+		t.Error("Unexpected Scan result:", err)
+	}
+
+	var b Bool
+	if err := b.Scan(true); err != nil || !b.Unwrap() {
+		t.Error("Unexpected Scan result:", err)
+	}
+}
@@ -0,0 +1,107 @@
+package protoopt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/oissevalt/opzione"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var anyOptionalType = reflect.TypeFor[opzione.AnyOptional]()
+
+// FieldMask returns a field mask with the paths of the optional fields of
+// patch, a struct or a pointer to struct, which contain a meaningful value,
+// such that an update RPC can be given both the patch and its mask. Fields of
+// nested structs which are not optionals, and not pointers, are included as
+// well, with their paths joined by dots.
+//
+// The name of a field in a path is the name in its protobuf tag, the name in
+// its json tag, or its name converted to snake case, in that order. Fields
+// tagged with json:"-" and unexported fields are ignored.
+func FieldMask(patch any) (*fieldmaskpb.FieldMask, error) {
+	val := reflect.ValueOf(patch)
+	for val.Kind() == reflect.Pointer && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("protoopt: cannot derive field mask from %T, which is not a struct", patch)
+	}
+
+	if !val.CanAddr() {
+		// Optionals may check their values with pointer receivers.
+		p := reflect.New(val.Type()).Elem()
+		p.Set(val)
+		val = p
+	}
+
+	mask := new(fieldmaskpb.FieldMask)
+	collectPaths(val, "", &mask.Paths)
+	return mask, nil
+}
+
+func collectPaths(val reflect.Value, prefix string, paths *[]string) {
+	typ := val.Type()
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+		name, ok := fieldName(sf)
+		if !ok {
+			continue
+		}
+		field, path := val.Field(i), prefix+name
+
+		switch {
+		case reflect.PointerTo(sf.Type).Implements(anyOptionalType):
+			if !field.Addr().Interface().(opzione.AnyOptional).IsNone() {
+				*paths = append(*paths, path)
+			}
+		case sf.Type.Implements(anyOptionalType) && sf.Type.Kind() == reflect.Pointer:
+			if !field.IsNil() && !field.Interface().(opzione.AnyOptional).IsNone() {
+				*paths = append(*paths, path)
+			}
+		case sf.Type.Kind() == reflect.Struct:
+			collectPaths(field, path+".", paths)
+		}
+	}
+}
+
+// fieldName returns the name of sf in field mask paths, and whether sf is
+// included at all.
+func fieldName(sf reflect.StructField) (string, bool) {
+	if !sf.IsExported() {
+		return "", false
+	}
+	for _, opt := range strings.Split(sf.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(opt, "name="); ok {
+			return name, true
+		}
+	}
+	switch name, _, _ := strings.Cut(sf.Tag.Get("json"), ","); name {
+	case "-":
+		return "", false
+	case "":
+		return snakeCase(sf.Name), true
+	default:
+		return name, true
+	}
+}
+
+// snakeCase converts a Go identifier such as DisplayName or UserID into
+// snake case, such as display_name or user_id.
+func snakeCase(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			lowerNext := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if i > 0 && (unicode.IsLower(rs[i-1]) || lowerNext) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
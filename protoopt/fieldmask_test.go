@@ -0,0 +1,50 @@
+package protoopt
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/oissevalt/opzione"
+)
+
+func TestFieldMask(t *testing.T) {
+	type address struct {
+		City opzione.Option[string]
+		Zip  opzione.Option[string] `json:"postal_code"`
+	}
+	type patch struct {
+		DisplayName opzione.Option[string]  `protobuf:"bytes,1,opt,name=display_name,json=displayName"`
+		Email       *opzione.Option[string] `json:"email_address,omitempty"`
+		UserID      *opzione.Option[int]
+		Age         opzione.Val[int]
+		Address     address
+		Ignored     opzione.Option[string] `json:"-"`
+		unexported  opzione.Option[string]
+	}
+
+	p := patch{
+		DisplayName: opzione.S("a"),
+		Email:       opzione.None[string](),
+		Age:         opzione.SomeVal(1),
+		Address:     address{Zip: opzione.S("1")},
+		Ignored:     opzione.S("x"),
+		unexported:  opzione.S("y"),
+	}
+	mask, err := FieldMask(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"display_name", "age", "address.postal_code"}
+	if !slices.Equal(mask.GetPaths(), want) {
+		t.Error("Unexpected paths:", mask.GetPaths())
+	}
+
+	p.UserID = opzione.Some(1)
+	if mask, _ = FieldMask(p); !slices.Contains(mask.GetPaths(), "user_id") {
+		t.Error("Unexpected paths:", mask.GetPaths())
+	}
+
+	if _, err = FieldMask(1); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/oissevalt/opzione/protoopt
+
+go 1.23
+
+require (
+	github.com/oissevalt/opzione v0.0.0-20261016034034-b2ab3fc73def
+	google.golang.org/protobuf v1.36.12
+)
@@ -0,0 +1,122 @@
+// Package protoopt provides conversions between options and the protobuf
+// well-known wrapper types.
+//
+// Proto3 optional scalar fields are represented as pointers in generated
+// code, which can be converted with opzione.FromPointer and Option.Ptr.
+package protoopt
+
+import (
+	"github.com/oissevalt/opzione"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type wrapper[T any] interface {
+	comparable
+	GetValue() T
+}
+
+func fromWrapper[T any, W wrapper[T]](w W) *opzione.Option[T] {
+	var zero W
+	if w == zero {
+		return opzione.None[T]()
+	}
+	return opzione.Some(w.GetValue())
+}
+
+func toWrapper[T, W any](o opzione.Optional[T], f func(T) W) W {
+	if o.IsNone() {
+		var zero W
+		return zero
+	}
+	return f(o.Unwrap())
+}
+
+// FromString converts w into an Option, which is None if w is nil.
+func FromString(w *wrapperspb.StringValue) *opzione.Option[string] {
+	return fromWrapper(w)
+}
+
+// ToString converts o into a wrapper, which is nil if o contains no value.
+func ToString(o opzione.Optional[string]) *wrapperspb.StringValue {
+	return toWrapper(o, wrapperspb.String)
+}
+
+// FromBytes converts w into an Option, which is None if w is nil.
+func FromBytes(w *wrapperspb.BytesValue) *opzione.Option[[]byte] {
+	return fromWrapper(w)
+}
+
+// ToBytes converts o into a wrapper, which is nil if o contains no value.
+func ToBytes(o opzione.Optional[[]byte]) *wrapperspb.BytesValue {
+	return toWrapper(o, wrapperspb.Bytes)
+}
+
+// FromBool converts w into an Option, which is None if w is nil.
+func FromBool(w *wrapperspb.BoolValue) *opzione.Option[bool] {
+	return fromWrapper(w)
+}
+
+// ToBool converts o into a wrapper, which is nil if o contains no value.
+func ToBool(o opzione.Optional[bool]) *wrapperspb.BoolValue {
+	return toWrapper(o, wrapperspb.Bool)
+}
+
+// FromInt32 converts w into an Option, which is None if w is nil.
+func FromInt32(w *wrapperspb.Int32Value) *opzione.Option[int32] {
+	return fromWrapper(w)
+}
+
+// ToInt32 converts o into a wrapper, which is nil if o contains no value.
+func ToInt32(o opzione.Optional[int32]) *wrapperspb.Int32Value {
+	return toWrapper(o, wrapperspb.Int32)
+}
+
+// FromInt64 converts w into an Option, which is None if w is nil.
+func FromInt64(w *wrapperspb.Int64Value) *opzione.Option[int64] {
+	return fromWrapper(w)
+}
+
+// ToInt64 converts o into a wrapper, which is nil if o contains no value.
+func ToInt64(o opzione.Optional[int64]) *wrapperspb.Int64Value {
+	return toWrapper(o, wrapperspb.Int64)
+}
+
+// FromUInt32 converts w into an Option, which is None if w is nil.
+func FromUInt32(w *wrapperspb.UInt32Value) *opzione.Option[uint32] {
+	return fromWrapper(w)
+}
+
+// ToUInt32 converts o into a wrapper, which is nil if o contains no value.
+func ToUInt32(o opzione.Optional[uint32]) *wrapperspb.UInt32Value {
+	return toWrapper(o, wrapperspb.UInt32)
+}
+
+// FromUInt64 converts w into an Option, which is None if w is nil.
+func FromUInt64(w *wrapperspb.UInt64Value) *opzione.Option[uint64] {
+	return fromWrapper(w)
+}
+
+// ToUInt64 converts o into a wrapper, which is nil if o contains no value.
+func ToUInt64(o opzione.Optional[uint64]) *wrapperspb.UInt64Value {
+	return toWrapper(o, wrapperspb.UInt64)
+}
+
+// FromFloat converts w into an Option, which is None if w is nil.
+func FromFloat(w *wrapperspb.FloatValue) *opzione.Option[float32] {
+	return fromWrapper(w)
+}
+
+// ToFloat converts o into a wrapper, which is nil if o contains no value.
+func ToFloat(o opzione.Optional[float32]) *wrapperspb.FloatValue {
+	return toWrapper(o, wrapperspb.Float)
+}
+
+// FromDouble converts w into an Option, which is None if w is nil.
+func FromDouble(w *wrapperspb.DoubleValue) *opzione.Option[float64] {
+	return fromWrapper(w)
+}
+
+// ToDouble converts o into a wrapper, which is nil if o contains no value.
+func ToDouble(o opzione.Optional[float64]) *wrapperspb.DoubleValue {
+	return toWrapper(o, wrapperspb.Double)
+}
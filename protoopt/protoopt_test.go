@@ -0,0 +1,28 @@
+package protoopt
+
+import (
+	"testing"
+
+	"github.com/oissevalt/opzione"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestWrappers(t *testing.T) {
+	if option := FromString(wrapperspb.String("a")); option.Unwrap() != "a" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := FromString(nil); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if w := ToInt64(opzione.Some[int64](1)); w.GetValue() != 1 {
+		t.Error("Unexpected wrapper:", w)
+	}
+	if w := ToInt64(opzione.None[int64]()); w != nil {
+		t.Error("Unexpected wrapper:", w)
+	}
+
+	if option := FromBytes(wrapperspb.Bytes(nil)); option.IsNone() {
+		t.Error("Unexpected None")
+	}
+}
@@ -0,0 +1,37 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"math/rand"
+	"reflect"
+	"testing/quick"
+)
+
+// GenOption generates an Option for property-based tests, which is None or
+// contains a value generated by gen with equal probability. The Option is
+// None as well if the value generated is nil.
+func GenOption[T any](rng *rand.Rand, gen func(*rand.Rand) T) *Option[T] {
+	if rng.Intn(2) == 0 {
+		return None[T]()
+	}
+	v := gen(rng)
+	return FromPointer(&v)
+}
+
+// Generate implements quick.Generator, so that testing/quick can generate
+// Options, and structs with Option fields, as if by GenOption with values
+// generated by quick.Value. The Option is None if quick.Value cannot generate
+// values of T.
+//
+// Since quick.Value calls Generate on the zero value of a type, it cannot
+// generate *Option, which is nil; use Option instead.
+func (Option[T]) Generate(rng *rand.Rand, size int) reflect.Value {
+	o := GenOption(rng, func(rng *rand.Rand) (t T) {
+		if v, ok := quick.Value(reflect.TypeFor[T](), rng); ok {
+			t = v.Interface().(T)
+		}
+		return
+	})
+	return reflect.ValueOf(*o)
+}
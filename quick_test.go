@@ -0,0 +1,56 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+// Interface assertions
+var _ quick.Generator = Option[int]{}
+
+func TestGenOption(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var some, none int
+	for range 100 {
+		if GenOption(rng, func(r *rand.Rand) int { return r.Int() }).IsNone() {
+			none++
+		} else {
+			some++
+		}
+	}
+	if some == 0 || none == 0 {
+		t.Error("Unexpected distribution:", some, none)
+	}
+
+	for range 10 {
+		if !GenOption(rng, func(*rand.Rand) *int { return nil }).IsNone() {
+			t.Error("Unexpected Some")
+		}
+	}
+}
+
+func TestOption_Generate(t *testing.T) {
+	type record struct {
+		Name Option[string]
+		Age  Option[int]
+	}
+
+	var some, none int
+	err := quick.Check(func(r record, n Option[int]) bool {
+		if r.Name.IsNone() {
+			none++
+		} else {
+			some++
+		}
+		return n.IsNone() || n.Unwrap() == *n.v
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if some == 0 || none == 0 {
+		t.Error("Unexpected distribution:", some, none)
+	}
+}
@@ -0,0 +1,47 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ErrRequired is wrapped in the errors returned by CheckRequired for each
+// required field which is None.
+var ErrRequired = errors.New("required field is none")
+
+// CheckRequired checks the Option fields of v, which must be a struct or a
+// pointer to struct, tagged with `opzione:"required"`, including those of
+// nested struct fields which are not pointers. It returns an error joining
+// one error for each of those fields which is None, or a nil *Option, naming
+// the field by its path, such as "Address.City"; each of them wraps
+// ErrRequired.
+//
+//	type Request struct {
+//		Name  opzione.Option[string] `opzione:"required"`
+//		Email opzione.Option[string]
+//	}
+func CheckRequired(v any) error {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Pointer {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("opzione: cannot check %T, which is not a struct", v)
+	}
+
+	var errs []error
+	for _, f := range optionFields(val.Type()) {
+		if !slices.Contains(strings.Split(f.Tag.Get("opzione"), ","), "required") {
+			continue
+		}
+		if _, ok := optionValue(val.FieldByIndex(f.Index).Interface()); !ok {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Path, ErrRequired))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,39 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckRequired(t *testing.T) {
+	type address struct {
+		City Option[string] `opzione:"required"`
+	}
+	type request struct {
+		Name    Option[string] `opzione:"required"`
+		Age     *Option[int]   `opzione:"required"`
+		Email   Option[string]
+		Address address
+	}
+
+	req := request{Name: S("a"), Age: Some(1), Address: address{City: S("Rome")}}
+	if err := CheckRequired(req); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+
+	req = request{Email: S("a@example.com")}
+	err := CheckRequired(&req)
+	if !errors.Is(err, ErrRequired) {
+		t.Fatal("Unexpected error:", err)
+	}
+	want := "Name: required field is none\nAge: required field is none\nAddress.City: required field is none"
+	if err.Error() != want {
+		t.Error("Unexpected error:", err)
+	}
+
+	if err = CheckRequired(1); err == nil || errors.Is(err, ErrRequired) {
+		t.Error("Unexpected error:", err)
+	}
+}
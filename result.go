@@ -0,0 +1,87 @@
+package opzione
+
+import "fmt"
+
+// Result is a container holding either a value or an error, complementing
+// Option in fallible pipelines.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+// Ok constructs a Result with value v.
+func Ok[T any](v T) *Result[T] {
+	return &Result[T]{v: v}
+}
+
+// Err constructs a Result with error err. It panics with a *NonePanicError
+// wrapping ErrNilValue if err is nil.
+func Err[T any](err error) *Result[T] {
+	if err == nil {
+		Raise[T](fmt.Errorf("%w: nil error cannot be used to construct Err", ErrNilValue))
+	}
+	return &Result[T]{err: err}
+}
+
+// FromOption constructs a Result from an Optional, with err as the error
+// if the optional contains no value. Like Err, it panics if err is nil and
+// the optional contains no value.
+func FromOption[T any](o Optional[T], err error) *Result[T] {
+	if o.IsNone() {
+		return Err[T](err)
+	}
+	return Ok(o.Unwrap())
+}
+
+// IsOk reports whether the Result contains a value.
+func (r *Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result contains an error.
+func (r *Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Value returns the contained value and error.
+func (r *Result[T]) Value() (T, error) {
+	return r.v, r.err
+}
+
+// Err returns the contained error, or nil if the Result contains a value.
+func (r *Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the contained value. If there is an error, it panics with a
+// *NonePanicError wrapping both ErrNoneOptional and the error.
+func (r *Result[T]) Unwrap() T {
+	if r.err != nil {
+		Raise[T](fmt.Errorf("%w: %w", ErrNoneOptional, r.err))
+	}
+	return r.v
+}
+
+// Option converts the Result into an Option, discarding the error. The
+// Option is None if the Result contains an error, or a nil pointer.
+func (r *Result[T]) Option() *Option[T] {
+	return Try(r.v, r.err)
+}
+
+// MapResult applies f to the value of r, if r contains one; otherwise the
+// error is carried over.
+func MapResult[T, U any](r *Result[T], f func(T) U) *Result[U] {
+	if r.err != nil {
+		return &Result[U]{err: r.err}
+	}
+	return Ok(f(r.v))
+}
+
+// AndThenResult applies f to the value of r, if r contains one, returning
+// the Result of f; otherwise the error is carried over.
+func AndThenResult[T, U any](r *Result[T], f func(T) *Result[U]) *Result[U] {
+	if r.err != nil {
+		return &Result[U]{err: r.err}
+	}
+	return f(r.v)
+}
@@ -0,0 +1,50 @@
+package opzione
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	errTest := errors.New("test")
+
+	ok := Ok("12")
+	if !ok.IsOk() || ok.IsErr() || ok.Unwrap() != "12" {
+		t.Error("Unexpected Result:", ok)
+	}
+
+	number := AndThenResult(ok, func(s string) *Result[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Err[int](err)
+		}
+		return Ok(n)
+	})
+	doubled := MapResult(number, func(n int) int { return n * 2 })
+	if v, err := doubled.Value(); v != 24 || err != nil {
+		t.Error("Unexpected value:", v, err)
+	}
+
+	failed := MapResult(Err[int](errTest), func(n int) int { return n * 2 })
+	if !failed.IsErr() || failed.Err() != errTest {
+		t.Error("Unexpected error:", failed.Err())
+	}
+	if !failed.Option().IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	ShouldPanic(t, func() {
+		failed.Unwrap()
+	}, true)
+	ShouldPanic(t, func() {
+		_ = Err[int](nil)
+	}, true)
+
+	if r := FromOption[int](None[int](), errTest); r.Err() != errTest {
+		t.Error("Unexpected error:", r.Err())
+	}
+	if r := FromOption[int](Some(1), errTest); r.Unwrap() != 1 {
+		t.Error("Unexpected value:", r.Unwrap())
+	}
+}
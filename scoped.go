@@ -0,0 +1,73 @@
+package opzione
+
+import "io"
+
+// Scoped is an optional resource which is closed automatically when it is
+// displaced by Swap, Take or Clear. Scoped itself implements io.Closer, so that
+// an optional resource can be cleaned up with a single deferred call:
+//
+//	conn := opzione.NewScoped(c)
+//	defer conn.Close()
+//
+// The zero value of Scoped contains no value.
+type Scoped[T io.Closer] struct {
+	opt Option[T]
+}
+
+// NewScoped constructs a Scoped with value v. Like Some, it panics if v is
+// a nil pointer.
+func NewScoped[T io.Closer](v T) *Scoped[T] {
+	return &Scoped[T]{opt: *Some(v)}
+}
+
+// IsNone reports whether the Scoped contains no meaningful value.
+func (s *Scoped[T]) IsNone() bool {
+	return s.opt.IsNone()
+}
+
+// Value attempts to retrieve the contained value, returning ErrNoneOptional
+// if there is none.
+func (s *Scoped[T]) Value() (T, error) {
+	return s.opt.Value()
+}
+
+// With executes f with the contained value, if any.
+func (s *Scoped[T]) With(f func(T)) {
+	s.opt.With(f)
+}
+
+// Swap replaces the contained value with v, closing the displaced value if
+// it is meaningful. It returns the error from closing.
+func (s *Scoped[T]) Swap(v T) error {
+	err := s.Clear()
+	s.opt = *FromPointer(&v)
+	return err
+}
+
+// Take moves the contained value out and closes it, leaving the Scoped in a
+// "none" state, so that the value can still be inspected after it has been
+// released. It returns the error from closing, or ErrNoneOptional if the
+// Scoped contains no meaningful value.
+func (s *Scoped[T]) Take() (T, error) {
+	p, err := s.opt.Take()
+	if err != nil {
+		var t T
+		return t, err
+	}
+	return *p, (*p).Close()
+}
+
+// Clear closes the contained value, if any, leaving the Scoped in a "none"
+// state. It returns the error from closing.
+func (s *Scoped[T]) Clear() error {
+	p, err := s.opt.Take()
+	if err != nil {
+		return nil
+	}
+	return (*p).Close()
+}
+
+// Close is the same as Clear, implementing io.Closer.
+func (s *Scoped[T]) Close() error {
+	return s.Clear()
+}
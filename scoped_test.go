@@ -0,0 +1,56 @@
+package opzione
+
+import (
+	"io"
+	"testing"
+)
+
+type closer struct {
+	closed bool
+}
+
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+// Interface assertions
+var _ io.Closer = &Scoped[*closer]{}
+
+func TestScoped(t *testing.T) {
+	first, second, third := &closer{}, &closer{}, &closer{}
+
+	scoped := NewScoped(first)
+	if err := scoped.Swap(second); err != nil {
+		t.Fatal(err)
+	}
+	if !first.closed {
+		t.Error("Displaced value not closed")
+	}
+
+	taken, err := scoped.Take()
+	if err != nil || taken != second || !second.closed {
+		t.Error("Unexpected Take result:", taken, err)
+	}
+	if !scoped.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err = scoped.Take(); err != ErrNoneOptional {
+		t.Error("Unexpected error:", err)
+	}
+
+	if err = scoped.Swap(third); err != nil {
+		t.Fatal(err)
+	}
+	if err = scoped.Close(); err != nil || !third.closed {
+		t.Error("Value not closed:", err)
+	}
+	if err = scoped.Close(); err != nil {
+		t.Error("Unexpected error closing None:", err)
+	}
+
+	var zero Scoped[*closer]
+	if !zero.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
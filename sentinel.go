@@ -0,0 +1,66 @@
+package opzione
+
+import "math"
+
+// ZeroAsNone makes o treat the zero value of T as None, as if by
+// AddValidator, and returns o. It bridges legacy code which uses zero
+// values as sentinels.
+func ZeroAsNone[T comparable](o *Option[T]) *Option[T] {
+	var zero T
+	o.AddValidator(func(v T) bool { return v == zero })
+	return o
+}
+
+// SomeNonZero constructs an Option with v, which is None if v is the zero
+// value of T. Like FromPointer, it does not panic if v is nil, and the Option
+// keeps treating the zero value as None as if by ZeroAsNone.
+func SomeNonZero[T comparable](v T) *Option[T] {
+	return ZeroAsNone(FromPointer(&v))
+}
+
+// NaNAsNone makes o treat NaN as None, as if by AddValidator, and returns o.
+func NaNAsNone[F ~float32 | ~float64](o *Option[F]) *Option[F] {
+	o.AddValidator(func(f F) bool { return math.IsNaN(float64(f)) })
+	return o
+}
+
+// NonFiniteAsNone makes o treat NaN and ±Inf as None, as if by
+// AddValidator, and returns o.
+func NonFiniteAsNone[F ~float32 | ~float64](o *Option[F]) *Option[F] {
+	o.AddValidator(func(f F) bool {
+		return math.IsNaN(float64(f)) || math.IsInf(float64(f), 0)
+	})
+	return o
+}
+
+// SomeFinite constructs an Option with f, which is None if f is NaN or ±Inf,
+// such as missing sensor readings. The Option keeps treating them as None as
+// if by NonFiniteAsNone; use NaNAsNone with Some to accept infinities.
+func SomeFinite[F ~float32 | ~float64](f F) *Option[F] {
+	return NonFiniteAsNone(Some(f))
+}
+
+// SomeNonEmpty constructs an Option with s, which is None if s is empty, as
+// is common with form values and configuration. The Option keeps treating
+// empty strings as None.
+func SomeNonEmpty[S ~string](s S) *Option[S] {
+	o := FromPointer(&s)
+	o.AddValidator(func(v S) bool { return len(v) == 0 })
+	return o
+}
+
+// SomeNonEmptySlice constructs an Option with xs, which is None if xs is nil
+// or empty. The Option keeps treating empty slices as None.
+func SomeNonEmptySlice[S ~[]E, E any](xs S) *Option[S] {
+	o := FromPointer(&xs)
+	o.AddValidator(func(v S) bool { return len(v) == 0 })
+	return o
+}
+
+// SomeNonEmptyMap constructs an Option with m, which is None if m is nil or
+// empty. The Option keeps treating empty maps as None.
+func SomeNonEmptyMap[M ~map[K]V, K comparable, V any](m M) *Option[M] {
+	o := FromPointer(&m)
+	o.AddValidator(func(v M) bool { return len(v) == 0 })
+	return o
+}
@@ -0,0 +1,77 @@
+package opzione
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSomeNonZero(t *testing.T) {
+	if !SomeNonZero(0).IsNone() || !SomeNonZero("").IsNone() || !SomeNonZero[*int](nil).IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option := SomeNonZero(1)
+	if option.IsNone() || option.Unwrap() != 1 {
+		t.Error("Unexpected None")
+	}
+	option.Swap(0)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option = Some(2)
+	option.Validate(func(v int) bool { return v > 10 })
+	ZeroAsNone(option)
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.Swap(11)
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestSomeFinite(t *testing.T) {
+	if !SomeFinite(math.NaN()).IsNone() || !SomeFinite(math.Inf(-1)).IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option := SomeFinite(float32(1.5))
+	if option.IsNone() || option.Unwrap() != 1.5 {
+		t.Error("Unexpected None")
+	}
+	option.Swap(float32(math.Inf(1)))
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	nan := NaNAsNone(Some(math.Inf(1)))
+	if nan.IsNone() {
+		t.Error("Unexpected None")
+	}
+	nan.Swap(math.NaN())
+	if !nan.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestSomeNonEmpty(t *testing.T) {
+	if !SomeNonEmpty("").IsNone() || SomeNonEmpty("a").IsNone() {
+		t.Error("Unexpected state")
+	}
+	if !SomeNonEmptySlice([]int(nil)).IsNone() || !SomeNonEmptySlice([]int{}).IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if !SomeNonEmptyMap(map[string]int(nil)).IsNone() || !SomeNonEmptyMap(map[string]int{}).IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	option := SomeNonEmptySlice([]int{1})
+	if option.IsNone() {
+		t.Error("Unexpected None")
+	}
+	option.Swap([]int{})
+	if !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,140 @@
+package opzione
+
+import "slices"
+
+// Collect converts a slice of optionals into an Option of the contained
+// values, which is Some only if every optional contains a value.
+func Collect[T any](opts []Optional[T]) *Option[[]T] {
+	values := make([]T, 0, len(opts))
+	for _, o := range opts {
+		v, err := o.Value()
+		if err != nil {
+			return None[[]T]()
+		}
+		values = append(values, v)
+	}
+	return Some(values)
+}
+
+// FilterMap applies f to every element of in, and returns the values of the
+// results which are Some, in order.
+func FilterMap[T, U any](in []T, f func(T) Optional[U]) []U {
+	var out []U
+	for _, v := range in {
+		if u, err := f(v).Value(); err == nil {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// Values returns the values contained in opts, in order, skipping those
+// which contain no value. Each optional is checked only once.
+func Values[T any](opts []Optional[T]) []T {
+	var values []T
+	for _, o := range opts {
+		if v, err := o.Value(); err == nil {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// SliceIndex returns the element of s at index i, or None if i is out of
+// range, or if the element is nil. Negative indices are out of range.
+func SliceIndex[T any](s []T, i int) *Option[T] {
+	if i < 0 || i >= len(s) {
+		return None[T]()
+	}
+	return FromPointer(&s[i])
+}
+
+// Find returns the first element of s satisfying pred, or None if there is
+// no such element.
+func Find[T any](s []T, pred func(T) bool) *Option[T] {
+	for i := range s {
+		if pred(s[i]) {
+			return FromPointer(&s[i])
+		}
+	}
+	return None[T]()
+}
+
+// First returns the first element of s, or None if s is empty.
+func First[T any](s []T) *Option[T] {
+	return SliceIndex(s, 0)
+}
+
+// Last returns the last element of s, or None if s is empty.
+func Last[T any](s []T) *Option[T] {
+	return SliceIndex(s, len(s)-1)
+}
+
+// Partition splits opts in a single pass, returning the values contained in
+// opts in order, and the number of optionals which contain no value.
+func Partition[T any](opts []Optional[T]) (some []T, noneCount int) {
+	for _, o := range opts {
+		if v, err := o.Value(); err == nil {
+			some = append(some, v)
+		} else {
+			noneCount++
+		}
+	}
+	return
+}
+
+// Traverse applies f to every element of in, and returns an Option of the
+// values of the results, which is None as soon as any result is None. It is
+// the all-or-nothing counterpart of FilterMap.
+func Traverse[T, U any](in []T, f func(T) Optional[U]) *Option[[]U] {
+	out := make([]U, 0, len(in))
+	for _, v := range in {
+		u, err := f(v).Value()
+		if err != nil {
+			return None[[]U]()
+		}
+		out = append(out, u)
+	}
+	return Some(out)
+}
+
+// CountSome returns the number of optionals in opts which contain a value.
+func CountSome[T any](opts []Optional[T]) int {
+	n := 0
+	for _, o := range opts {
+		if !o.IsNone() {
+			n++
+		}
+	}
+	return n
+}
+
+// AnySome reports whether any optional in opts contains a value.
+func AnySome[T any](opts []Optional[T]) bool {
+	for _, o := range opts {
+		if !o.IsNone() {
+			return true
+		}
+	}
+	return false
+}
+
+// AllSome reports whether every optional in opts contains a value. It
+// returns true if opts is empty.
+func AllSome[T any](opts []Optional[T]) bool {
+	for _, o := range opts {
+		if o.IsNone() {
+			return false
+		}
+	}
+	return true
+}
+
+// Compact removes the optionals which contain no value from opts in place,
+// reusing its backing array, and returns the shortened slice. The elements
+// between the new length and the original length are zeroed.
+func Compact[T any](opts []Optional[T]) []Optional[T] {
+	return slices.DeleteFunc(opts, func(o Optional[T]) bool {
+		return o.IsNone()
+	})
+}
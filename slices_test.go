@@ -0,0 +1,114 @@
+package opzione
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	collected := Collect([]Optional[int]{Some(1), Some(2)})
+	if values := collected.Unwrap(); len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Error("Unexpected values:", values)
+	}
+
+	if collected = Collect([]Optional[int]{Some(1), None[int]()}); !collected.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if collected = Collect[int](nil); collected.IsNone() || len(collected.Unwrap()) != 0 {
+		t.Error("Unexpected result for empty input")
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	out := FilterMap([]string{"1", "a", "3"}, func(s string) Optional[int] {
+		return Try(strconv.Atoi(s))
+	})
+	if len(out) != 2 || out[0] != 1 || out[1] != 3 {
+		t.Error("Unexpected values:", out)
+	}
+}
+
+func TestValues(t *testing.T) {
+	values := Values([]Optional[int]{Some(1), None[int](), Some(3)})
+	if len(values) != 2 || values[0] != 1 || values[1] != 3 {
+		t.Error("Unexpected values:", values)
+	}
+}
+
+func TestSliceIndex(t *testing.T) {
+	s := []string{"a", "b"}
+	if option := SliceIndex(s, 1); option.Unwrap() != "b" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := SliceIndex(s, 2); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if option := SliceIndex(s, -1); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestFind(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	if option := Find(s, func(n int) bool { return n%2 == 0 }); option.Unwrap() != 2 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := Find(s, func(n int) bool { return n > 4 }); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	if First(s).Unwrap() != 1 || Last(s).Unwrap() != 4 {
+		t.Error("Unexpected first or last element")
+	}
+	if !First[int](nil).IsNone() || !Last[int](nil).IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestPartition(t *testing.T) {
+	some, noneCount := Partition([]Optional[int]{None[int](), Some(2), None[int]()})
+	if len(some) != 1 || some[0] != 2 || noneCount != 2 {
+		t.Error("Unexpected result:", some, noneCount)
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	atoi := func(s string) Optional[int] {
+		return Try(strconv.Atoi(s))
+	}
+
+	out := Traverse([]string{"1", "2"}, atoi)
+	if values := out.Unwrap(); len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Error("Unexpected values:", values)
+	}
+	if out = Traverse([]string{"1", "a"}, atoi); !out.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
+
+func TestCountSome(t *testing.T) {
+	opts := []Optional[int]{Some(1), None[int](), Some(3)}
+	if n := CountSome(opts); n != 2 {
+		t.Error("Unexpected count:", n)
+	}
+	if !AnySome(opts) || AllSome(opts) {
+		t.Error("Unexpected predicates")
+	}
+	if AnySome[int](nil) || !AllSome[int](nil) {
+		t.Error("Unexpected predicates for empty input")
+	}
+}
+
+func TestCompact(t *testing.T) {
+	opts := []Optional[int]{None[int](), Some(2), None[int](), Some(4)}
+	compacted := Compact(opts)
+	if len(compacted) != 2 || compacted[0].Unwrap() != 2 || compacted[1].Unwrap() != 4 {
+		t.Error("Unexpected result:", compacted)
+	}
+	if &compacted[0] != &opts[0] {
+		t.Error("Backing array not reused")
+	}
+	if opts[2] != nil || opts[3] != nil {
+		t.Error("Tail not zeroed")
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. The contained value is logged as it
+// is, and an Option containing no meaningful value is logged as "none".
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.StringValue("none")
+	}
+	return slog.AnyValue(*o.v)
+}
@@ -0,0 +1,27 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestOption_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("test", "some", Some(1), "none", None[int]())
+	if got := strings.TrimSpace(buf.String()); got != "level=INFO msg=test some=1 none=none" {
+		t.Error("Unexpected log:", got)
+	}
+}
@@ -0,0 +1,155 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// Scan implements sql.Scanner, so that an Option can be used as a scan
+// destination. NULL results in None, while other values are converted to T
+// as database/sql does for plain destinations.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		o.unset()
+		return nil
+	}
+
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	o.set(n.V)
+	return nil
+}
+
+// Valuer returns a driver.Valuer for the Option, to be used as a query
+// argument. The Option cannot implement driver.Valuer itself, since its
+// Value method returns the contained value. The driver value is NULL if
+// the Option contains no meaningful value.
+//
+//	db.Exec("UPDATE users SET name = ?", opt.Valuer())
+func (o Option[T]) Valuer() driver.Valuer {
+	return valuer[T](o)
+}
+
+type valuer[T any] Option[T]
+
+func (v valuer[T]) Value() (driver.Value, error) {
+	o := Option[T](v)
+	if o.IsNone() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(*o.v)
+}
+
+// FromNull constructs an Option from n, which is None if n is not valid.
+func FromNull[T any](n sql.Null[T]) *Option[T] {
+	return Of(n.V, n.Valid)
+}
+
+// ToNull converts o into sql.Null, which is valid if o contains a value.
+func ToNull[T any](o Optional[T]) sql.Null[T] {
+	if o.IsNone() {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: o.Unwrap(), Valid: true}
+}
+
+// FromNullString constructs an Option from n, which is None if n is not
+// valid.
+func FromNullString(n sql.NullString) *Option[string] {
+	return Of(n.String, n.Valid)
+}
+
+// ToNullString converts o into sql.NullString.
+func ToNullString(o Optional[string]) sql.NullString {
+	n := ToNull(o)
+	return sql.NullString{String: n.V, Valid: n.Valid}
+}
+
+// FromNullInt64 constructs an Option from n, which is None if n is not
+// valid.
+func FromNullInt64(n sql.NullInt64) *Option[int64] {
+	return Of(n.Int64, n.Valid)
+}
+
+// ToNullInt64 converts o into sql.NullInt64.
+func ToNullInt64(o Optional[int64]) sql.NullInt64 {
+	n := ToNull(o)
+	return sql.NullInt64{Int64: n.V, Valid: n.Valid}
+}
+
+// FromNullInt32 constructs an Option from n, which is None if n is not
+// valid.
+func FromNullInt32(n sql.NullInt32) *Option[int32] {
+	return Of(n.Int32, n.Valid)
+}
+
+// ToNullInt32 converts o into sql.NullInt32.
+func ToNullInt32(o Optional[int32]) sql.NullInt32 {
+	n := ToNull(o)
+	return sql.NullInt32{Int32: n.V, Valid: n.Valid}
+}
+
+// FromNullInt16 constructs an Option from n, which is None if n is not
+// valid.
+func FromNullInt16(n sql.NullInt16) *Option[int16] {
+	return Of(n.Int16, n.Valid)
+}
+
+// ToNullInt16 converts o into sql.NullInt16.
+func ToNullInt16(o Optional[int16]) sql.NullInt16 {
+	n := ToNull(o)
+	return sql.NullInt16{Int16: n.V, Valid: n.Valid}
+}
+
+// FromNullByte constructs an Option from n, which is None if n is not
+// valid.
+func FromNullByte(n sql.NullByte) *Option[byte] {
+	return Of(n.Byte, n.Valid)
+}
+
+// ToNullByte converts o into sql.NullByte.
+func ToNullByte(o Optional[byte]) sql.NullByte {
+	n := ToNull(o)
+	return sql.NullByte{Byte: n.V, Valid: n.Valid}
+}
+
+// FromNullFloat64 constructs an Option from n, which is None if n is not
+// valid.
+func FromNullFloat64(n sql.NullFloat64) *Option[float64] {
+	return Of(n.Float64, n.Valid)
+}
+
+// ToNullFloat64 converts o into sql.NullFloat64.
+func ToNullFloat64(o Optional[float64]) sql.NullFloat64 {
+	n := ToNull(o)
+	return sql.NullFloat64{Float64: n.V, Valid: n.Valid}
+}
+
+// FromNullBool constructs an Option from n, which is None if n is not
+// valid.
+func FromNullBool(n sql.NullBool) *Option[bool] {
+	return Of(n.Bool, n.Valid)
+}
+
+// ToNullBool converts o into sql.NullBool.
+func ToNullBool(o Optional[bool]) sql.NullBool {
+	n := ToNull(o)
+	return sql.NullBool{Bool: n.V, Valid: n.Valid}
+}
+
+// FromNullTime constructs an Option from n, which is None if n is not
+// valid.
+func FromNullTime(n sql.NullTime) *Option[time.Time] {
+	return Of(n.Time, n.Valid)
+}
+
+// ToNullTime converts o into sql.NullTime.
+func ToNullTime(o Optional[time.Time]) sql.NullTime {
+	n := ToNull(o)
+	return sql.NullTime{Time: n.V, Valid: n.Valid}
+}
@@ -0,0 +1,78 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+// Interface assertions
+var _ sql.Scanner = &Option[int]{}
+
+func TestOption_Scan(t *testing.T) {
+	var option Option[int64]
+	if err := option.Scan(int64(12)); err != nil {
+		t.Fatal(err)
+	}
+	if option.Unwrap() != 12 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	if err := option.Scan(nil); err != nil || !option.IsNone() {
+		t.Error("Unexpected result:", err)
+	}
+
+	var str Option[string]
+	if err := str.Scan([]byte("bytes")); err != nil || str.Unwrap() != "bytes" {
+		t.Error("Unexpected result:", err)
+	}
+
+	var tm Option[time.Time]
+	if err := tm.Scan("not a time"); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestOption_Valuer(t *testing.T) {
+	v, err := S(12).Valuer().Value()
+	if err != nil || v != int64(12) {
+		t.Error("Unexpected result:", v, err)
+	}
+	if !driver.IsValue(v) {
+		t.Error("Not a driver value:", v)
+	}
+
+	if v, err = N[int]().Valuer().Value(); err != nil || v != nil {
+		t.Error("Unexpected result:", v, err)
+	}
+}
+
+func TestNullConversions(t *testing.T) {
+	if option := FromNull(sql.Null[int]{V: 1, Valid: true}); option.Unwrap() != 1 {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if option := FromNull(sql.Null[int]{V: 1}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if n := ToNull[int](None[int]()); n.Valid {
+		t.Error("Unexpected valid Null")
+	}
+
+	if option := FromNullString(sql.NullString{String: "a", Valid: true}); option.Unwrap() != "a" {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+	if n := ToNullString(Some("a")); !n.Valid || n.String != "a" {
+		t.Error("Unexpected NullString:", n)
+	}
+
+	now := time.Now()
+	if n := ToNullTime(Some(now)); !n.Valid || !n.Time.Equal(now) {
+		t.Error("Unexpected NullTime:", n)
+	}
+	if option := FromNullTime(sql.NullTime{}); !option.IsNone() {
+		t.Error("Unexpected Some")
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SomeStrict is like Some, but rejects values whose behaviour in an Option
+// can be surprising, returning an error wrapping ErrStrict instead of
+// panicking. Besides nil pointers and nested pointers to nil, it rejects
+// unsafe pointers, which are never tracked, and interface-typed T holding
+// a typed nil, which is a non-nil interface wrapping a nil pointer.
+func SomeStrict[T any](v T) (*Option[T], error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() == reflect.UnsafePointer {
+		return nil, fmt.Errorf("%w: unsafe pointer %v is not tracked", ErrStrict, typ)
+	}
+
+	val := reflect.ValueOf(v)
+	if typ.Kind() == reflect.Interface && val.IsValid() && isptrkind(val.Kind()) && val.IsNil() {
+		return nil, fmt.Errorf("%w: interface %v holds typed nil %v", ErrStrict, typ, val.Type())
+	}
+	if isnil(val) {
+		return nil, fmt.Errorf("%w: nil %v", ErrStrict, typ)
+	}
+	return Some(v), nil
+}
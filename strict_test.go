@@ -0,0 +1,35 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"unsafe"
+)
+
+func TestSomeStrict(t *testing.T) {
+	if option, err := SomeStrict(1); err != nil || option.Unwrap() != 1 {
+		t.Error("Unexpected result:", option, err)
+	}
+
+	number := 1
+	if _, err := SomeStrict(unsafe.Pointer(&number)); !errors.Is(err, ErrStrict) {
+		t.Error("Unexpected error:", err)
+	}
+
+	var file *os.File
+	if _, err := SomeStrict[error](&os.PathError{}); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+	if _, err := SomeStrict[interface{ Close() error }](file); !errors.Is(err, ErrStrict) {
+		t.Error("Unexpected error:", err)
+	}
+	if _, err := SomeStrict[error](nil); !errors.Is(err, ErrStrict) {
+		t.Error("Unexpected error:", err)
+	}
+	if _, err := SomeStrict(file); !errors.Is(err, ErrStrict) {
+		t.Error("Unexpected error:", err)
+	}
+}
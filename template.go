@@ -0,0 +1,43 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "text/template"
+
+// TemplateFuncs returns functions for working with options inside templates
+// without the risk of panicking on None:
+//
+//	isSome    reports whether an option contains a value
+//	isNone    reports whether an option contains no value
+//	deref     returns the contained value, or nil
+//	orDefault returns the contained value, or the given default
+//
+// For example:
+//
+//	{{if isSome .Nickname}}{{deref .Nickname}}{{end}}
+//	{{.Nickname | orDefault "anonymous"}}
+//
+// Arguments which are not options are treated as values, and are considered
+// absent if they are nil. The result can be converted to html/template.FuncMap.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"isSome": func(x any) bool {
+			_, ok := optionValue(x)
+			return ok
+		},
+		"isNone": func(x any) bool {
+			_, ok := optionValue(x)
+			return !ok
+		},
+		"deref": func(x any) any {
+			v, _ := optionValue(x)
+			return v
+		},
+		"orDefault": func(def, x any) any {
+			if v, ok := optionValue(x); ok {
+				return v
+			}
+			return def
+		},
+	}
+}
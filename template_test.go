@@ -0,0 +1,41 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	data := struct {
+		Name     Option[string]
+		Nickname *Option[string]
+		Age      *Option[int]
+	}{S("Mario"), None[string](), nil}
+
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(
+		`{{deref .Name}} {{.Nickname | orDefault "anonymous"}} {{isSome .Age}} {{isNone .Nickname}}`,
+	))
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatal(err)
+	}
+	if got := sb.String(); got != "Mario anonymous false true" {
+		t.Error("Unexpected output:", got)
+	}
+
+	html := htmltemplate.Must(htmltemplate.New("").Funcs(htmltemplate.FuncMap(TemplateFuncs())).Parse(
+		`{{if isSome .Name}}<b>{{deref .Name}}</b>{{end}}`,
+	))
+	sb.Reset()
+	if err := html.Execute(&sb, data); err != nil {
+		t.Fatal(err)
+	}
+	if got := sb.String(); got != "<b>Mario</b>" {
+		t.Error("Unexpected output:", got)
+	}
+}
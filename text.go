@@ -0,0 +1,56 @@
+package opzione
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler. The contained value is
+// marshalled by its own MarshalText method, or formatted with strconv if it
+// is a string, a boolean or a number. An Option containing no meaningful
+// value is marshalled as the empty string.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if o.IsNone() {
+		return []byte{}, nil
+	}
+	if m, ok := any(*o.v).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	if text, ok := formatBasic(*o.v); ok {
+		return text, nil
+	}
+	return nil, fmt.Errorf("opzione: %v does not implement encoding.TextMarshaler", typeName[T]())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The value is unmarshalled
+// by the UnmarshalText method of T or *T, or parsed with strconv if T is a
+// string, a boolean or a number type. The empty string results in None.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		o.unset()
+		return nil
+	}
+
+	var v T
+	if u, ok := unmarshaler[encoding.TextUnmarshaler](&v); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if ok, err := parseBasic(text, &v); err != nil {
+		return fmt.Errorf("opzione: cannot unmarshal %q into %v: %w", text, typeName[T](), err)
+	} else if !ok {
+		return fmt.Errorf("opzione: %v does not implement encoding.TextUnmarshaler", typeName[T]())
+	}
+	o.set(v)
+	return nil
+}
+
+// unmarshaler returns v as U if it implements U. Otherwise, if T is a pointer
+// type whose element implements U, it allocates the element into *v and
+// returns the new pointer as U.
+func unmarshaler[U, T any](v *T) (U, bool) {
+	if u, ok := any(v).(U); ok {
+		return u, true
+	}
+	return newelem[U](v)
+}
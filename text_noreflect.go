@@ -0,0 +1,100 @@
+//go:build opzione_noreflect
+
+package opzione
+
+import "strconv"
+
+// formatBasic formats v with strconv if it is of a predeclared basic type,
+// that is a string, a boolean or a number. Named types cannot be told apart
+// from structs without reflection, so they are not supported.
+func formatBasic(v any) ([]byte, bool) {
+	switch v := v.(type) {
+	case string:
+		return []byte(v), true
+	case bool:
+		return strconv.AppendBool(nil, v), true
+	case int:
+		return strconv.AppendInt(nil, int64(v), 10), true
+	case int8:
+		return strconv.AppendInt(nil, int64(v), 10), true
+	case int16:
+		return strconv.AppendInt(nil, int64(v), 10), true
+	case int32:
+		return strconv.AppendInt(nil, int64(v), 10), true
+	case int64:
+		return strconv.AppendInt(nil, v, 10), true
+	case uint:
+		return strconv.AppendUint(nil, uint64(v), 10), true
+	case uint8:
+		return strconv.AppendUint(nil, uint64(v), 10), true
+	case uint16:
+		return strconv.AppendUint(nil, uint64(v), 10), true
+	case uint32:
+		return strconv.AppendUint(nil, uint64(v), 10), true
+	case uint64:
+		return strconv.AppendUint(nil, v, 10), true
+	case uintptr:
+		return strconv.AppendUint(nil, uint64(v), 10), true
+	case float32:
+		return strconv.AppendFloat(nil, float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.AppendFloat(nil, v, 'g', -1, 64), true
+	default:
+		return nil, false
+	}
+}
+
+// parseBasic parses text into *p with strconv if *p is of a predeclared
+// basic type, as accepted by formatBasic, reporting whether it is.
+func parseBasic[T any](text []byte, p *T) (ok bool, err error) {
+	s := string(text)
+	switch p := any(p).(type) {
+	case *string:
+		*p = s
+	case *bool:
+		*p, err = strconv.ParseBool(s)
+	case *int:
+		err = parseInt(s, p, strconv.IntSize)
+	case *int8:
+		err = parseInt(s, p, 8)
+	case *int16:
+		err = parseInt(s, p, 16)
+	case *int32:
+		err = parseInt(s, p, 32)
+	case *int64:
+		err = parseInt(s, p, 64)
+	case *uint:
+		err = parseUint(s, p, strconv.IntSize)
+	case *uint8:
+		err = parseUint(s, p, 8)
+	case *uint16:
+		err = parseUint(s, p, 16)
+	case *uint32:
+		err = parseUint(s, p, 32)
+	case *uint64:
+		err = parseUint(s, p, 64)
+	case *uintptr:
+		err = parseUint(s, p, strconv.IntSize)
+	case *float32:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		*p = float32(f)
+	case *float64:
+		*p, err = strconv.ParseFloat(s, 64)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func parseInt[I int | int8 | int16 | int32 | int64](s string, p *I, bits int) error {
+	i, err := strconv.ParseInt(s, 10, bits)
+	*p = I(i)
+	return err
+}
+
+func parseUint[U uint | uint8 | uint16 | uint32 | uint64 | uintptr](s string, p *U, bits int) error {
+	u, err := strconv.ParseUint(s, 10, bits)
+	*p = U(u)
+	return err
+}
@@ -0,0 +1,57 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// formatBasic formats v with strconv if it is of a basic kind, that is a
+// string, a boolean or a number, including named types such as time.Month.
+func formatBasic(v any) ([]byte, bool) {
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.String:
+		return []byte(val.String()), true
+	case reflect.Bool:
+		return strconv.AppendBool(nil, val.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, val.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, val.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, val.Float(), 'g', -1, val.Type().Bits()), true
+	default:
+		return nil, false
+	}
+}
+
+// parseBasic parses text into *p with strconv if *p is of a basic kind, as
+// accepted by formatBasic, reporting whether it is.
+func parseBasic[T any](text []byte, p *T) (ok bool, err error) {
+	val, s := reflect.ValueOf(p).Elem(), string(text)
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, val.Type().Bits())
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, val.Type().Bits())
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, val.Type().Bits())
+		val.SetFloat(f)
+	default:
+		return false, nil
+	}
+	return true, err
+}
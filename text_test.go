@@ -0,0 +1,87 @@
+package opzione
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOption_Text(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	text, err := Some(now).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var option Option[time.Time]
+	if err = option.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if !option.Unwrap().Equal(now) {
+		t.Error("Unexpected value:", option.Unwrap())
+	}
+
+	if text, err = None[time.Time]().MarshalText(); err != nil || len(text) != 0 {
+		t.Error("Unexpected result:", string(text), err)
+	}
+	if err = option.UnmarshalText(nil); err != nil || !option.IsNone() {
+		t.Error("Unexpected result:", err)
+	}
+
+	// Pointers to unmarshalers are allocated with reflection.
+	if !noreflect {
+		var ptr Option[*time.Time]
+		if err = ptr.UnmarshalText([]byte(now.Format(time.RFC3339))); err != nil {
+			t.Fatal(err)
+		}
+		if !ptr.Unwrap().Equal(now) {
+			t.Error("Unexpected value:", ptr.Unwrap())
+		}
+	}
+
+	if _, err = Some(struct{}{}).MarshalText(); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	if err = new(Option[struct{}]).UnmarshalText([]byte("{}")); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
+
+func TestOption_TextBasic(t *testing.T) {
+	if text, err := Some(-12).MarshalText(); err != nil || string(text) != "-12" {
+		t.Error("Unexpected result:", string(text), err)
+	}
+	if text, err := Some(float32(0.1)).MarshalText(); err != nil || string(text) != "0.1" {
+		t.Error("Unexpected result:", string(text), err)
+	}
+	if text, err := Some("a b").MarshalText(); err != nil || string(text) != "a b" {
+		t.Error("Unexpected result:", string(text), err)
+	}
+
+	var i Option[int8]
+	if err := i.UnmarshalText([]byte("-12")); err != nil || i.Unwrap() != -12 {
+		t.Error("Unexpected result:", i, err)
+	}
+	if err := i.UnmarshalText([]byte("300")); err == nil {
+		t.Error("Unexpected nil error")
+	}
+	var b Option[bool]
+	if err := b.UnmarshalText([]byte("true")); err != nil || !b.Unwrap() {
+		t.Error("Unexpected result:", b, err)
+	}
+	var s Option[string]
+	if err := s.UnmarshalText([]byte("a b")); err != nil || s.Unwrap() != "a b" {
+		t.Error("Unexpected result:", s, err)
+	}
+
+	// Named types are only supported with reflection.
+	if !noreflect {
+		if text, err := Some(time.March).MarshalText(); err != nil || string(text) != "3" {
+			t.Error("Unexpected result:", string(text), err)
+		}
+		var m Option[time.Month]
+		if err := m.UnmarshalText([]byte("3")); err != nil || m.Unwrap() != time.March {
+			t.Error("Unexpected result:", m, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package opzione
+
+import (
+	"runtime"
+	"sync"
+)
+
+// tracer records the stack trace of the latest transition of an Option to
+// None. It is only used when built with the opzione_debug tag.
+type tracer struct {
+	mu    sync.Mutex
+	stack []byte
+}
+
+// observe records the current stack trace if the Option becomes none, or
+// clears it if the Option contains a meaningful value.
+func (t *tracer) observe(none bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch {
+	case !none:
+		t.stack = nil
+	case t.stack == nil:
+		buf := make([]byte, 4096)
+		t.stack = buf[:runtime.Stack(buf, false)]
+	}
+}
+
+// NoneTrace returns the stack trace of the latest transition of the Option
+// to None, such as its construction, Swap with nil, Take, or a tracked
+// reference becoming nil, which is recorded when it is first observed by
+// IsNone. It helps finding out which code path emptied an Option, but it is
+// only available when built with the opzione_debug tag; otherwise, or if the
+// Option contains a meaningful value, it returns an empty string.
+func (o *Option[T]) NoneTrace() string {
+	m := o.loadMeta()
+	if m == nil || m.trace == nil {
+		return ""
+	}
+	m.trace.mu.Lock()
+	defer m.trace.mu.Unlock()
+	return string(m.trace.stack)
+}
+
+// traced records the state of the Option after a modification in debug
+// builds. It must be called with exclusive access to the Option.
+func (o *Option[T]) traced() {
+	if !debug {
+		return
+	}
+	m := o.meta()
+	if m.trace == nil {
+		m.trace = new(tracer)
+	}
+	m.trace.observe(o.none())
+}
@@ -0,0 +1,39 @@
+//go:build opzione_debug
+
+package opzione
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNoneTrace(t *testing.T) {
+	option := None[*int]()
+	if !strings.Contains(option.NoneTrace(), "TestNoneTrace") {
+		t.Error("Unexpected trace:", option.NoneTrace())
+	}
+
+	i := 1
+	option.Swap(&i)
+	if option.NoneTrace() != "" {
+		t.Error("Unexpected trace:", option.NoneTrace())
+	}
+
+	takeOption(option)
+	if !strings.Contains(option.NoneTrace(), "takeOption") {
+		t.Error("Unexpected trace:", option.NoneTrace())
+	}
+
+	if !noreflect {
+		p := &i
+		tracked := Some(&p)
+		p = nil
+		if !tracked.IsNone() || !strings.Contains(tracked.NoneTrace(), "TestNoneTrace") {
+			t.Error("Unexpected trace:", tracked.NoneTrace())
+		}
+	}
+}
+
+func takeOption(o *Option[*int]) {
+	_, _ = o.Take()
+}
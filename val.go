@@ -0,0 +1,115 @@
+package opzione
+
+import "iter"
+
+// Val is an optional value stored inline, along with a flag reporting its
+// presence, instead of behind a pointer like Option. Constructing and
+// reading a Val of a small value type does not allocate, which makes it
+// suitable for numeric-heavy workloads.
+//
+// Val does not inspect the contained value; a nil pointer stored in a Val
+// is considered present. Use Option to track pointers.
+//
+// The zero value of Val contains no value.
+type Val[T any] struct {
+	value   T
+	present bool
+}
+
+// SomeVal constructs a Val containing v.
+func SomeVal[T any](v T) Val[T] {
+	return Val[T]{value: v, present: true}
+}
+
+// NoneVal constructs a Val with no value.
+func NoneVal[T any]() Val[T] {
+	return Val[T]{}
+}
+
+// IsNone reports whether the Val contains no value.
+func (v Val[T]) IsNone() bool {
+	return !v.present
+}
+
+// IsSome reports whether the Val contains a value.
+func (v Val[T]) IsSome() bool {
+	return v.present
+}
+
+// Value returns the contained value, or ErrNoneOptional if there is none.
+func (v Val[T]) Value() (T, error) {
+	if !v.present {
+		return v.value, ErrNoneOptional
+	}
+	return v.value, nil
+}
+
+// Get returns the contained value, and whether there is one.
+func (v Val[T]) Get() (T, bool) {
+	return v.value, v.present
+}
+
+// Unwrap returns the contained value, panicking if there is none.
+func (v Val[T]) Unwrap() T {
+	if !v.present {
+		Raise[T](ErrNoneOptional)
+	}
+	return v.value
+}
+
+// Swap stores t and returns the original value, which is the zero value if
+// the Val contained no value.
+func (v *Val[T]) Swap(t T) T {
+	old := v.value
+	v.value, v.present = t, true
+	return old
+}
+
+// Take moves the contained value out, leaving the Val with no value. It
+// returns ErrNoneOptional if there is no value.
+func (v *Val[T]) Take() (*T, error) {
+	if !v.present {
+		return nil, ErrNoneOptional
+	}
+	t := v.value
+	*v = Val[T]{}
+	return &t, nil
+}
+
+// With executes f with the contained value, if any.
+func (v Val[T]) With(f func(T)) {
+	if v.present {
+		f(v.value)
+	}
+}
+
+// WithNone executes f if the Val contains no value.
+func (v Val[T]) WithNone(f func()) {
+	if !v.present {
+		f()
+	}
+}
+
+// Assign assigns a pointer to the contained value to *p, if there is one.
+// It reports whether an assignment is made.
+func (v *Val[T]) Assign(p **T) bool {
+	if v.present {
+		*p = &v.value
+	}
+	return v.present
+}
+
+// Iter returns an iterator yielding the contained value, if any.
+func (v Val[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if v.present {
+			yield(v.value)
+		}
+	}
+}
+
+// Option converts the Val into an Option, which tracks the value as Some
+// does. The Option is None if the Val contains no value, or a nil pointer.
+func (v Val[T]) Option() *Option[T] {
+	return Of(v.value, v.present)
+}
@@ -0,0 +1,40 @@
+package opzione
+
+import "testing"
+
+// Interface assertions
+var _ Optional[int] = &Val[int]{}
+
+func TestVal(t *testing.T) {
+	v := SomeVal(1)
+	if v.IsNone() || v.Unwrap() != 1 {
+		t.Error("Unexpected state")
+	}
+	if old := v.Swap(2); old != 1 || v.Unwrap() != 2 {
+		t.Error("Unexpected Swap result:", old)
+	}
+	if p, err := v.Take(); err != nil || *p != 2 || !v.IsNone() {
+		t.Error("Unexpected Take result:", err)
+	}
+
+	var zero Val[int]
+	if !zero.IsNone() || !NoneVal[int]().IsNone() {
+		t.Error("Unexpected Some")
+	}
+	if _, err := zero.Value(); err != ErrNoneOptional {
+		t.Error("Unexpected error:", err)
+	}
+	if !SomeVal[*int](nil).Option().IsNone() {
+		t.Error("Unexpected Some")
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		v := SomeVal(12)
+		if n, err := v.Value(); err != nil || n != 12 {
+			t.Fatal("Unexpected value:", n)
+		}
+	})
+	if allocs != 0 {
+		t.Error("Unexpected allocations:", allocs)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "reflect"
+
+// ValidatorTypeFunc is a CustomTypeFunc for github.com/go-playground/validator,
+// which makes validation apply to the values contained in Options. It is to
+// be registered for each Option type in use:
+//
+//	validate.RegisterCustomTypeFunc(opzione.ValidatorTypeFunc,
+//		opzione.Option[string]{}, opzione.Option[int]{})
+//
+// Tags on Option and *Option fields then apply to the contained value, which
+// is nil if there is no meaningful value, such that `validate:"required"`
+// requires the Option to be Some, and `validate:"omitempty,email"` validates
+// the contained value only if there is one.
+func ValidatorTypeFunc(field reflect.Value) any {
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	v, ok := optionValue(field.Interface())
+	if !ok {
+		return nil
+	}
+	return v
+}
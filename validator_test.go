@@ -0,0 +1,25 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidatorTypeFunc(t *testing.T) {
+	if v := ValidatorTypeFunc(reflect.ValueOf(S("a"))); v != "a" {
+		t.Error("Unexpected value:", v)
+	}
+	if v := ValidatorTypeFunc(reflect.ValueOf(Some(1))); v != 1 {
+		t.Error("Unexpected value:", v)
+	}
+	for _, x := range []any{N[string](), None[int](), (*Option[int])(nil)} {
+		if v := ValidatorTypeFunc(reflect.ValueOf(x)); v != nil {
+			t.Error("Unexpected value:", v)
+		}
+	}
+	if v := ValidatorTypeFunc(reflect.Value{}); v != nil {
+		t.Error("Unexpected value:", v)
+	}
+}
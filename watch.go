@@ -0,0 +1,244 @@
+package opzione
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// WatchPolicy decides what happens when a subscriber created by Watch does
+// not keep up with changes of the Option.
+type WatchPolicy int
+
+const (
+	// WatchBlock makes the modifying call wait until the subscriber has
+	// room in its buffer for the event.
+	WatchBlock WatchPolicy = iota
+
+	// WatchDropOldest discards the oldest pending event to make room for
+	// the newest one, so the modifying call never blocks.
+	WatchDropOldest
+
+	// WatchCoalesce keeps at most one pending event, the latest state of
+	// the Option, regardless of the requested buffer size.
+	WatchCoalesce
+)
+
+// Event describes the state of an Option after it has been modified.
+type Event[T any] struct {
+	// Value is the contained value, or the zero value if None is true.
+	Value T
+
+	// None reports whether the Option was none after the modification.
+	None bool
+}
+
+type watcher[T any] struct {
+	ch     chan Event[T]
+	policy WatchPolicy
+
+	// done is closed on cancellation, releasing a blocked sender, after
+	// which ch is closed with mu held for writing. Senders hold mu for
+	// reading, so that ch is never closed under them.
+	done   chan struct{}
+	mu     sync.RWMutex
+	closed bool
+	once   sync.Once
+}
+
+func (w *watcher[T]) send(e Event[T]) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return
+	}
+
+	if w.policy == WatchBlock {
+		select {
+		case w.ch <- e:
+		case <-w.done:
+		}
+		return
+	}
+	for {
+		select {
+		case w.ch <- e:
+			return
+		default:
+		}
+		// The buffer is full; drop the oldest event and try again.
+		select {
+		case <-w.ch:
+		default:
+		}
+	}
+}
+
+// close closes the channel of the watcher, once no sender is using it.
+func (w *watcher[T]) close() {
+	w.once.Do(func() {
+		close(w.done)
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.closed = true
+		close(w.ch)
+	})
+}
+
+// subscribers holds the watchers and hooks of an Option.
+type subscribers[T any] struct {
+	mu       sync.Mutex
+	watchers []*watcher[T]
+	hooks    []*hook[T]
+}
+
+// subscribers returns the subscribers of the Option, allocating them on
+// first use. It is safe to call concurrently.
+func (o *Option[T]) subscribers() *subscribers[T] {
+	m := o.meta()
+	for {
+		if p := atomic.LoadPointer(&m.subs); p != nil {
+			return (*subscribers[T])(p)
+		}
+		atomic.CompareAndSwapPointer(&m.subs, nil, unsafe.Pointer(new(subscribers[T])))
+	}
+}
+
+// loadSubscribers returns the subscribers of the Option, or nil if there
+// have never been any.
+func (o *Option[T]) loadSubscribers() *subscribers[T] {
+	m := o.loadMeta()
+	if m == nil {
+		return nil
+	}
+	return (*subscribers[T])(atomic.LoadPointer(&m.subs))
+}
+
+// Watch subscribes to modifications of the Option made through its methods,
+// such as Swap, Take, Scan and the decoding methods. Each modification sends
+// an Event carrying the new state to the returned channel, which holds up to
+// size pending events and behaves according to policy when full. Changes to
+// tracked references made outside the Option cannot be observed.
+//
+// The returned function cancels the subscription and closes the channel. It
+// may be called from any goroutine, and releases a modifying call blocked
+// by WatchBlock. A modification of a LockedOption blocked by WatchBlock holds
+// its lock until the event is received or the subscription is cancelled.
+func (o *Option[T]) Watch(size int, policy WatchPolicy) (<-chan Event[T], func()) {
+	switch {
+	case policy == WatchCoalesce:
+		size = 1
+	case policy == WatchDropOldest && size < 1:
+		size = 1
+	case size < 0:
+		size = 0
+	}
+
+	w := &watcher[T]{
+		ch:     make(chan Event[T], size),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	s := o.subscribers()
+	s.mu.Lock()
+	s.watchers = append(s.watchers, w)
+	s.mu.Unlock()
+
+	cancel := func() {
+		s.mu.Lock()
+		s.watchers = slices.DeleteFunc(s.watchers, func(x *watcher[T]) bool {
+			return x == w
+		})
+		s.mu.Unlock()
+		w.close()
+	}
+	return w.ch, cancel
+}
+
+type hook[T any] struct {
+	some func(T)
+	none func()
+}
+
+// OnSome registers f to be called with the new value whenever a method of
+// the Option, such as Swap, Scan or UnmarshalJSON, transitions it from None
+// to Some. The returned function unregisters f.
+//
+// Hooks run synchronously in the goroutine modifying the Option, after the
+// modification. For the Option underlying a LockedOption, they run while its
+// write lock is held, so they must not call methods of the LockedOption.
+func (o *Option[T]) OnSome(f func(T)) func() {
+	return o.addHook(&hook[T]{some: f})
+}
+
+// OnNone registers f to be called whenever a method of the Option, such as
+// Swap, Take or decoding null, transitions it from Some to None. The returned
+// function unregisters f. Like OnSome hooks, f must not call methods of a
+// LockedOption it belongs to.
+func (o *Option[T]) OnNone(f func()) func() {
+	return o.addHook(&hook[T]{none: f})
+}
+
+func (o *Option[T]) addHook(h *hook[T]) func() {
+	s := o.subscribers()
+	s.mu.Lock()
+	s.hooks = append(s.hooks, h)
+	s.mu.Unlock()
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.hooks = slices.DeleteFunc(s.hooks, func(x *hook[T]) bool {
+			return x == h
+		})
+	}
+}
+
+// notify informs watchers and hooks of a modification. wasNone is the state
+// before the modification, which is only meaningful if there are hooks. The
+// subscribers are called without holding their lock, so that they may
+// cancel their subscription.
+func (o *Option[T]) notify(wasNone bool) {
+	s := o.loadSubscribers()
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	watchers, hooks := slices.Clone(s.watchers), slices.Clone(s.hooks)
+	s.mu.Unlock()
+	if len(watchers) == 0 && len(hooks) == 0 {
+		return
+	}
+
+	var e Event[T]
+	if e.None = o.IsNone(); !e.None {
+		e.Value = *o.v
+	}
+	for _, w := range watchers {
+		w.send(e)
+	}
+
+	if wasNone == e.None {
+		return
+	}
+	for _, h := range hooks {
+		switch {
+		case e.None && h.none != nil:
+			h.none()
+		case !e.None && h.some != nil:
+			h.some(e.Value)
+		}
+	}
+}
+
+// hasHooks reports whether any hook is registered, in which case the state
+// before a modification must be known.
+func (o *Option[T]) hasHooks() bool {
+	s := o.loadSubscribers()
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.hooks) > 0
+}
@@ -0,0 +1,122 @@
+package opzione
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOption_Watch(t *testing.T) {
+	option := Some(1)
+
+	coalesced, cancel := option.Watch(8, WatchCoalesce)
+	defer cancel()
+	dropping, cancel2 := option.Watch(2, WatchDropOldest)
+
+	option.Swap(2)
+	option.Swap(3)
+	_, _ = option.Take()
+
+	if e := <-coalesced; !e.None {
+		t.Error("Unexpected event:", e)
+	}
+	if len(coalesced) != 0 {
+		t.Error("Events not coalesced:", len(coalesced))
+	}
+
+	if e := <-dropping; e.None || e.Value != 3 {
+		t.Error("Unexpected event:", e)
+	}
+	if e := <-dropping; !e.None {
+		t.Error("Unexpected event:", e)
+	}
+
+	cancel2()
+	if _, ok := <-dropping; ok {
+		t.Error("Channel not closed after cancel")
+	}
+
+	option = Some(1)
+	blocking, cancel3 := option.Watch(0, WatchBlock)
+	defer cancel3()
+	go option.Swap(4)
+	if e := <-blocking; e.None || e.Value != 4 {
+		t.Error("Unexpected event:", e)
+	}
+}
+
+func TestOption_Hooks(t *testing.T) {
+	option := Some(1)
+
+	var somes []int
+	nones := 0
+	option.OnSome(func(v int) {
+		somes = append(somes, v)
+	})
+	unregister := option.OnNone(func() {
+		nones++
+	})
+
+	option.Swap(2)
+	_, _ = option.Take()
+	option.Swap(3)
+	option.Swap(4)
+
+	if len(somes) != 1 || somes[0] != 3 || nones != 1 {
+		t.Error("Unexpected transitions:", somes, nones)
+	}
+
+	unregister()
+	_, _ = option.Take()
+	if nones != 1 {
+		t.Error("Hook called after unregistering")
+	}
+}
+
+func TestOption_WatchCancel(t *testing.T) {
+	option := Some(1)
+
+	// Cancelling releases a writer blocked on a full channel.
+	_, cancel := option.Watch(0, WatchBlock)
+	swapped := make(chan struct{})
+	go func() {
+		option.Swap(2)
+		close(swapped)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	<-swapped
+
+	// Cancelling concurrently with modifications is safe.
+	var wg sync.WaitGroup
+	for range 8 {
+		events, cancel := option.Watch(0, WatchBlock)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range events {
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			cancel()
+		}()
+	}
+	for i := range 100 {
+		option.Swap(i)
+	}
+	wg.Wait()
+	cancel()
+}
+
+func TestLockedOption_Hooks(t *testing.T) {
+	var somes int
+	locked := new(LockedOption[int])
+	locked.Mutate(func(o *Option[int]) {
+		o.OnSome(func(int) { somes++ })
+	})
+	locked.Swap(1)
+	if !locked.CompareAndSwap(1, 2) || somes != 1 {
+		t.Error("Unexpected transitions:", somes)
+	}
+}
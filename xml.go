@@ -0,0 +1,44 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import "encoding/xml"
+
+// MarshalXML implements xml.Marshaler. The contained value is encoded as
+// the element, and the element is omitted if the Option contains no
+// meaningful value.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.IsNone() {
+		return nil
+	}
+	return e.EncodeElement(*o.v, start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr. The contained value is
+// marshalled as text, as by MarshalText, and the attribute is omitted if the
+// Option contains no meaningful value. Attributes are unmarshalled by
+// UnmarshalText.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if o.IsNone() {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(*o.v).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	text, err := o.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler. Absent elements are left
+// untouched by encoding/xml, and a zero Option is None.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	o.set(v)
+	return nil
+}
@@ -0,0 +1,61 @@
+//go:build !opzione_noreflect
+
+package opzione
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestOption_XML(t *testing.T) {
+	type config struct {
+		XMLName xml.Name       `xml:"config"`
+		Host    Option[string] `xml:"host"`
+		Port    Option[int]    `xml:"port"`
+	}
+
+	data, err := xml.Marshal(config{Host: S("localhost"), Port: N[int]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "<config><host>localhost</host></config>" {
+		t.Error("Unexpected XML:", string(data))
+	}
+
+	var c config
+	if err = xml.Unmarshal([]byte("<config><port>80</port></config>"), &c); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Host.IsNone() || c.Port.Unwrap() != 80 {
+		t.Error("Unexpected result:", c)
+	}
+}
+
+func TestOption_XMLAttr(t *testing.T) {
+	type server struct {
+		XMLName xml.Name       `xml:"server"`
+		Name    Option[string] `xml:"name,attr"`
+		Port    Option[uint16] `xml:"port,attr"`
+		Weight  Option[int]    `xml:"weight,attr"`
+	}
+
+	data, err := xml.Marshal(server{Name: S("a b"), Port: S[uint16](80), Weight: N[int]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `<server name="a b" port="80"></server>` {
+		t.Error("Unexpected XML:", string(data))
+	}
+
+	var s server
+	if err = xml.Unmarshal(data, &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Name.Unwrap() != "a b" || s.Port.Unwrap() != 80 || !s.Weight.IsNone() {
+		t.Error("Unexpected result:", s)
+	}
+
+	if err = xml.Unmarshal([]byte(`<server port="http"></server>`), &s); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}
@@ -0,0 +1,25 @@
+package opzione
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. The contained value is marshalled as it is, and an
+// Option containing no meaningful value is marshalled as null.
+func (o Option[T]) MarshalYAML() (any, error) {
+	if o.IsNone() {
+		return nil, nil
+	}
+	return *o.v, nil
+}
+
+// UnmarshalYAML implements the function-based Unmarshaler interface, which
+// is honoured by both gopkg.in/yaml.v2 and gopkg.in/yaml.v3 and does not
+// require this package to depend on either. The decoders do not call it for
+// null, which leaves the Option untouched, so null results in None only if
+// the Option is decoded into afresh; a *Option is set to nil instead.
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	o.set(v)
+	return nil
+}
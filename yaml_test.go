@@ -0,0 +1,46 @@
+package opzione
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestOption_YAML(t *testing.T) {
+	type config struct {
+		Host  Option[string]  `yaml:"host"`
+		Port  Option[int]     `yaml:"port"`
+		Tags  Option[[]int]   `yaml:"tags"`
+		Proxy *Option[string] `yaml:"proxy"`
+	}
+
+	data, err := yaml.Marshal(config{Host: S("localhost"), Port: N[int]()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "host: localhost\nport: null\ntags: null\nproxy: null\n" {
+		t.Error("Unexpected YAML:", string(data))
+	}
+
+	var c config
+	if err = yaml.Unmarshal([]byte("port: 80\ntags: [1, 2]\nproxy: null\n"), &c); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Host.IsNone() || c.Port.Unwrap() != 80 || len(c.Tags.Unwrap()) != 2 || c.Proxy != nil {
+		t.Error("Unexpected result:", c)
+	}
+
+	// The decoder does not call UnmarshalYAML for null, so null leaves an
+	// Option untouched, while a *Option is set to nil.
+	c.Proxy = Some("proxy")
+	if err = yaml.Unmarshal([]byte("port: null\nproxy: null\n"), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Port.Unwrap() != 80 || c.Proxy != nil {
+		t.Error("Unexpected result:", c)
+	}
+
+	if err = yaml.Unmarshal([]byte("port: http\n"), &c); err == nil {
+		t.Error("Unexpected nil error")
+	}
+}